@@ -14,6 +14,9 @@ type AllInput struct {
 	Limit          int             `query:"ps_limit"`
 	DisableProcCPU bool            `query:"disable_proc_cpu" default:"false"`
 	MergeChildren  bool            `query:"merge_children" default:"true"`
+	Scope          gops.Scope      `query:"scope" doc:"Report the cpu, memory and process sections against the host or the cgroup's CPU and memory limits (auto uses the cgroup for the limits it sets; defaults to the server's --scope)"`
+
+	NetworkFilterParams
 }
 
 type AllResponse struct {
@@ -25,7 +28,12 @@ type AllResponse struct {
 // GET /all
 func (self *HandlerGroup) All(ctx context.Context, input *AllInput) (*AllResponse, error) {
 	enableCPU := !input.DisableProcCPU
-	all, err := self.srv.Gops.GetAllMetrics(input.SortBy, input.Limit, enableCPU, input.MergeChildren)
+	g, err := self.srv.Gops.WithScope(input.Scope).WithNetworkFilter(input.filter())
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+
+	all, err := g.GetAllMetrics(input.SortBy, input.Limit, enableCPU, input.MergeChildren)
 	if err != nil {
 		log.Error("Error getting all metrics")
 		return nil, huma.Error500InternalServerError("Unable to retrieve all metrics")
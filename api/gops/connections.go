@@ -0,0 +1,37 @@
+package gops_handler
+
+import (
+	"context"
+
+	"github.com/AvengeMedia/dgop/gops"
+	"github.com/AvengeMedia/dgop/internal/log"
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type ConnectionsInput struct {
+	Protocol string `query:"protocol" enum:"tcp,tcp6,udp,udp6,unix" doc:"Only sockets of this protocol (tcp and udp include IPv6)"`
+	State    string `query:"state" example:"LISTEN" doc:"Only sockets in this state, e.g. LISTEN, ESTABLISHED, TIME_WAIT, UNCONN"`
+	Port     int    `query:"port" minimum:"0" maximum:"65535" doc:"Only sockets with this local or remote port"`
+	PID      int32  `query:"pid" minimum:"0" doc:"Only sockets owned by this process"`
+}
+
+type ConnectionsResponse struct {
+	Body *models.ConnectionsResponse
+}
+
+// GET /connections
+func (self *HandlerGroup) Connections(ctx context.Context, input *ConnectionsInput) (*ConnectionsResponse, error) {
+	conns, err := self.srv.Gops.GetConnections(gops.ConnectionFilter{
+		Protocol: input.Protocol,
+		State:    input.State,
+		Port:     input.Port,
+		PID:      input.PID,
+	})
+	if err != nil {
+		log.Error("Error getting connections")
+		return nil, huma.Error500InternalServerError("Unable to retrieve connections")
+	}
+
+	return &ConnectionsResponse{Body: conns}, nil
+}
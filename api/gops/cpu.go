@@ -3,13 +3,15 @@ package gops_handler
 import (
 	"context"
 
+	"github.com/AvengeMedia/dgop/gops"
 	"github.com/AvengeMedia/dgop/internal/log"
 	"github.com/AvengeMedia/dgop/models"
 	"github.com/danielgtaylor/huma/v2"
 )
 
 type CpuInput struct {
-	Cursor string `query:"cursor" required:"false"`
+	Cursor string     `query:"cursor" required:"false"`
+	Scope  gops.Scope `query:"scope" doc:"Report CPU usage against the host CPUs or the cgroup's cpu.max/cpuset share (auto uses the cgroup when it limits CPU; defaults to the server's --scope)"`
 }
 
 type CpuResponse struct {
@@ -20,7 +22,7 @@ type CpuResponse struct {
 
 // GET /cpu
 func (self *HandlerGroup) Cpu(ctx context.Context, input *CpuInput) (*CpuResponse, error) {
-	cpuInfo, err := self.srv.Gops.GetCPUInfoWithCursor(input.Cursor)
+	cpuInfo, err := self.srv.Gops.WithScope(input.Scope).GetCPUInfoWithCursor(input.Cursor)
 	if err != nil {
 		log.Error("Error getting CPU info")
 		return nil, huma.Error500InternalServerError("Unable to retrieve CPU info")
@@ -28,21 +28,84 @@ func (self *HandlerGroup) Disk(ctx context.Context, _ *server.EmptyInput) (*Disk
 	return resp, nil
 }
 
-// GET /disk/mounts
+type DiskMountsInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor from previous request, enables fill-rate forecasts"`
+}
+
 type DiskMountsResponse struct {
 	Body struct {
-		Data []*models.DiskMountInfo `json:"data"`
+		Data   []*models.DiskMountInfo `json:"data"`
+		Cursor string                  `json:"cursor"`
 	}
 }
 
-func (self *HandlerGroup) DiskMounts(ctx context.Context, _ *server.EmptyInput) (*DiskMountsResponse, error) {
-	diskMountsInfo, err := self.srv.Gops.GetDiskMounts()
+// GET /disk/mounts
+func (self *HandlerGroup) DiskMounts(ctx context.Context, input *DiskMountsInput) (*DiskMountsResponse, error) {
+	diskMountsInfo, err := self.srv.Gops.GetDiskMountsWithCursor(input.Cursor)
 	if err != nil {
 		log.Error("Error getting Disk Mounts info")
 		return nil, huma.Error500InternalServerError("Unable to retrieve Disk Mounts info")
 	}
 
 	resp := &DiskMountsResponse{}
-	resp.Body.Data = diskMountsInfo
+	resp.Body.Data = diskMountsInfo.Mounts
+	resp.Body.Cursor = diskMountsInfo.Cursor
+	return resp, nil
+}
+
+type BlockDevicesResponse struct {
+	Body struct {
+		Data []*models.BlockDevice `json:"data"`
+	}
+}
+
+// GET /blockdevices
+func (self *HandlerGroup) BlockDevices(ctx context.Context, _ *server.EmptyInput) (*BlockDevicesResponse, error) {
+	devices, err := self.srv.Gops.GetBlockDevices()
+	if err != nil {
+		log.Error("Error getting block devices")
+		return nil, huma.Error500InternalServerError("Unable to retrieve block devices")
+	}
+
+	resp := &BlockDevicesResponse{}
+	resp.Body.Data = devices
+	return resp, nil
+}
+
+type SmartResponse struct {
+	Body struct {
+		Data []*models.SmartDevice `json:"data"`
+	}
+}
+
+// GET /smart
+func (self *HandlerGroup) Smart(ctx context.Context, _ *server.EmptyInput) (*SmartResponse, error) {
+	devices, err := self.srv.Gops.GetSmart()
+	if err != nil {
+		log.Error("Error getting SMART health")
+		return nil, huma.Error500InternalServerError("Unable to retrieve SMART health")
+	}
+
+	resp := &SmartResponse{}
+	resp.Body.Data = devices
+	return resp, nil
+}
+
+type StoragePoolsResponse struct {
+	Body struct {
+		Data []*models.StoragePool `json:"data"`
+	}
+}
+
+// GET /storagepools
+func (self *HandlerGroup) StoragePools(ctx context.Context, _ *server.EmptyInput) (*StoragePoolsResponse, error) {
+	pools, err := self.srv.Gops.GetStoragePools()
+	if err != nil {
+		log.Error("Error getting storage pools")
+		return nil, huma.Error500InternalServerError("Unable to retrieve storage pools")
+	}
+
+	resp := &StoragePoolsResponse{}
+	resp.Body.Data = pools
 	return resp, nil
 }
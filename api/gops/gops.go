@@ -52,6 +52,42 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		handlers.Memory,
 	)
 
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "zram",
+			Summary:     "Get zram and zswap Info",
+			Description: "Get original, compressed and memory-used bytes for zram devices and the zswap pool",
+			Path:        "/zram",
+			Method:      http.MethodGet,
+		},
+		handlers.Zram,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "numa",
+			Summary:     "Get NUMA Info",
+			Description: "Get per-node memory usage and numa_hit/miss/foreign counters with cursor-based miss rates",
+			Path:        "/numa",
+			Method:      http.MethodGet,
+		},
+		handlers.NUMA,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "vmstat",
+			Summary:     "Get vmstat Rates",
+			Description: "Get page fault, swap, reclaim, THP and OOM kill counters from /proc/vmstat with cursor-based per-second rates",
+			Path:        "/vmstat",
+			Method:      http.MethodGet,
+		},
+		handlers.VMStat,
+	)
+
 	huma.Register(
 		grp,
 		huma.Operation{
@@ -76,18 +112,66 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		handlers.NetRate,
 	)
 
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "connections",
+			Summary:     "Get Socket Connections",
+			Description: "Get TCP, UDP and unix sockets with addresses, state, queue sizes, UID and owning process, plus counts per TCP state",
+			Path:        "/connections",
+			Method:      http.MethodGet,
+		},
+		handlers.Connections,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "netstack",
+			Summary:     "Get TCP/IP Stack Health",
+			Description: "Get TCP retransmit, reset, listen overflow, UDP buffer error, ICMP unreachable and SYN cookie counters from /proc/net/snmp, snmp6 and netstat with cursor-based per-second rates",
+			Path:        "/netstack",
+			Method:      http.MethodGet,
+		},
+		handlers.NetStack,
+	)
+
 	huma.Register(
 		grp,
 		huma.Operation{
 			OperationID: "disk-rate",
 			Summary:     "Get Disk I/O Rates",
-			Description: "Get disk I/O rates with cursor-based sampling for accurate rate calculations",
+			Description: "Get disk I/O rates, IOPS, await, request size, queue size, %util and discard/flush rates with cursor-based sampling (iostat -x)",
 			Path:        "/disk-rate",
 			Method:      http.MethodGet,
 		},
 		handlers.DiskRate,
 	)
 
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "pressure",
+			Summary:     "Get Pressure Stall Info",
+			Description: "Get CPU, memory, I/O and IRQ pressure stall information with cursor-based stall rates",
+			Path:        "/pressure",
+			Method:      http.MethodGet,
+		},
+		handlers.Pressure,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "interrupts",
+			Summary:     "Get Interrupt Rates",
+			Description: "Get per-CPU hardware interrupt and softirq counters with cursor-based rates",
+			Path:        "/interrupts",
+			Method:      http.MethodGet,
+		},
+		handlers.Interrupts,
+	)
+
 	huma.Register(
 		grp,
 		huma.Operation{
@@ -136,6 +220,42 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		handlers.DiskMounts,
 	)
 
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "blockdevices",
+			Summary:     "Get Block Devices",
+			Description: "Get the block device tree: disks with model, serial, transport and queue settings, their partitions and dm-crypt, LVM and md devices stacked on them",
+			Path:        "/blockdevices",
+			Method:      http.MethodGet,
+		},
+		handlers.BlockDevices,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "smart",
+			Summary:     "Get SMART Health",
+			Description: "Get per-drive health, temperature, power-on hours, reallocated/pending sectors and NVMe wear from smartctl, falling back to NVMe sysfs attributes when smartctl is unavailable",
+			Path:        "/smart",
+			Method:      http.MethodGet,
+		},
+		handlers.Smart,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "storagepools",
+			Summary:     "Get Storage Pool Health",
+			Description: "Get md arrays, btrfs filesystems and ZFS pools with a normalised healthy/degraded/faulted status, member state, error counters and resync/scrub progress",
+			Path:        "/storagepools",
+			Method:      http.MethodGet,
+		},
+		handlers.StoragePools,
+	)
+
 	huma.Register(
 		grp,
 		huma.Operation{
@@ -148,6 +268,30 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		handlers.SystemHardware,
 	)
 
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "sensors",
+			Summary:     "Get Hardware Sensors",
+			Description: "Get every hwmon temperature, fan, voltage, current and power channel with limits and alarm flags",
+			Path:        "/sensors",
+			Method:      http.MethodGet,
+		},
+		handlers.Sensors,
+	)
+
+	huma.Register(
+		grp,
+		huma.Operation{
+			OperationID: "topology",
+			Summary:     "Get CPU Topology",
+			Description: "Get CPU topology including packages, cores, SMT siblings, caches, NUMA nodes and hybrid core types",
+			Path:        "/topology",
+			Method:      http.MethodGet,
+		},
+		handlers.CPUTopology,
+	)
+
 	huma.Register(
 		grp,
 		huma.Operation{
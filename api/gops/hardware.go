@@ -12,6 +12,16 @@ type SystemHardwareResponse struct {
 	Body *models.SystemHardware
 }
 
+type CPUTopologyResponse struct {
+	Body *models.CPUTopology
+}
+
+type SensorsResponse struct {
+	Body struct {
+		Data []*models.SensorChip `json:"data"`
+	}
+}
+
 type GPUResponse struct {
 	Body *models.GPUInfo
 }
@@ -35,6 +45,30 @@ func (self *HandlerGroup) SystemHardware(ctx context.Context, input *struct{}) (
 	return &SystemHardwareResponse{Body: systemInfo}, nil
 }
 
+// GET /sensors
+func (self *HandlerGroup) Sensors(ctx context.Context, input *struct{}) (*SensorsResponse, error) {
+	chips, err := self.srv.Gops.GetSensors()
+	if err != nil {
+		log.Error("Error getting sensors")
+		return nil, huma.Error500InternalServerError("Unable to retrieve sensors")
+	}
+
+	resp := &SensorsResponse{}
+	resp.Body.Data = chips
+	return resp, nil
+}
+
+// GET /topology
+func (self *HandlerGroup) CPUTopology(ctx context.Context, input *struct{}) (*CPUTopologyResponse, error) {
+	topology, err := self.srv.Gops.GetCPUTopology()
+	if err != nil {
+		log.Error("Error getting CPU topology")
+		return nil, huma.Error500InternalServerError("Unable to retrieve CPU topology")
+	}
+
+	return &CPUTopologyResponse{Body: topology}, nil
+}
+
 // GET /gpu
 func (self *HandlerGroup) GPU(ctx context.Context, input *struct{}) (*GPUResponse, error) {
 	gpuInfo, err := self.srv.Gops.GetGPUInfo()
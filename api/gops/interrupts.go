@@ -0,0 +1,30 @@
+package gops_handler
+
+import (
+	"context"
+
+	"github.com/AvengeMedia/dgop/internal/log"
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type InterruptsInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor for interrupt rate calculation"`
+}
+
+type InterruptsResponse struct {
+	Body *models.InterruptsResponse
+}
+
+// GET /interrupts
+func (self *HandlerGroup) Interrupts(ctx context.Context, input *InterruptsInput) (*InterruptsResponse, error) {
+	interruptsInfo, err := self.srv.Gops.GetInterrupts(input.Cursor)
+	if err != nil {
+		log.Error("Error getting interrupts info")
+		return nil, huma.Error500InternalServerError("Unable to retrieve interrupts info")
+	}
+
+	resp := &InterruptsResponse{}
+	resp.Body = interruptsInfo
+	return resp, nil
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 
 	"github.com/AvengeMedia/dgop/api/server"
+	"github.com/AvengeMedia/dgop/gops"
 	"github.com/AvengeMedia/dgop/internal/log"
 	"github.com/AvengeMedia/dgop/models"
 	"github.com/danielgtaylor/huma/v2"
 )
 
+type MemoryInput struct {
+	Scope gops.Scope `query:"scope" doc:"Report total, used and available memory for the host or against the cgroup's memory.max (auto uses the cgroup when it limits memory; defaults to the server's --scope)"`
+}
+
 type MemoryResponse struct {
 	Body struct {
 		Data *models.MemoryInfo `json:"data"`
@@ -16,9 +21,9 @@ type MemoryResponse struct {
 }
 
 // GET /memory
-func (self *HandlerGroup) Memory(ctx context.Context, _ *server.EmptyInput) (*MemoryResponse, error) {
+func (self *HandlerGroup) Memory(ctx context.Context, input *MemoryInput) (*MemoryResponse, error) {
 
-	memoryInfo, err := self.srv.Gops.GetMemoryInfo()
+	memoryInfo, err := self.srv.Gops.WithScope(input.Scope).GetMemoryInfo()
 	if err != nil {
 		log.Error("Error getting memory info")
 		return nil, huma.Error500InternalServerError("Unable to retrieve memory info")
@@ -28,3 +33,65 @@ func (self *HandlerGroup) Memory(ctx context.Context, _ *server.EmptyInput) (*Me
 	resp.Body.Data = memoryInfo
 	return resp, nil
 }
+
+type ZramResponse struct {
+	Body struct {
+		Data *models.ZramInfo `json:"data"`
+	}
+}
+
+// GET /zram
+func (self *HandlerGroup) Zram(ctx context.Context, _ *server.EmptyInput) (*ZramResponse, error) {
+
+	zramInfo, err := self.srv.Gops.GetZram()
+	if err != nil {
+		log.Error("Error getting zram info")
+		return nil, huma.Error500InternalServerError("Unable to retrieve zram info")
+	}
+
+	resp := &ZramResponse{}
+	resp.Body.Data = zramInfo
+	return resp, nil
+}
+
+type NUMAInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor for NUMA miss rate calculation"`
+}
+
+type NUMAResponse struct {
+	Body *models.NUMAResponse
+}
+
+// GET /numa
+func (self *HandlerGroup) NUMA(ctx context.Context, input *NUMAInput) (*NUMAResponse, error) {
+	numaInfo, err := self.srv.Gops.GetNUMA(input.Cursor)
+	if err != nil {
+		log.Error("Error getting NUMA info")
+		return nil, huma.Error500InternalServerError("Unable to retrieve NUMA info")
+	}
+
+	resp := &NUMAResponse{}
+	resp.Body = numaInfo
+	return resp, nil
+}
+
+type VMStatInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor for vmstat rate calculation"`
+}
+
+type VMStatResponse struct {
+	Body *models.VMStatResponse
+}
+
+// GET /vmstat
+func (self *HandlerGroup) VMStat(ctx context.Context, input *VMStatInput) (*VMStatResponse, error) {
+	vmstatInfo, err := self.srv.Gops.GetVMStat(input.Cursor)
+	if err != nil {
+		log.Error("Error getting vmstat info")
+		return nil, huma.Error500InternalServerError("Unable to retrieve vmstat info")
+	}
+
+	resp := &VMStatResponse{}
+	resp.Body = vmstatInfo
+	return resp, nil
+}
@@ -16,13 +16,23 @@ type MetaInput struct {
 	Limit          int             `query:"limit" default:"0"`
 	DisableProcCPU bool            `query:"disable_proc_cpu" default:"false"`
 	MergeChildren  bool            `query:"merge_children" default:"true"`
+	Scope          gops.Scope      `query:"scope" doc:"Report the cpu, memory and processes modules against the host or the cgroup's CPU and memory limits (auto uses the cgroup for the limits it sets; defaults to the server's --scope)"`
 
 	// Module-specific parameters
-	GPUPciIds      []string `query:"gpu_pci_ids" example:"10de:2684,1002:164e" doc:"PCI IDs for GPU temperatures (when gpu module is requested)"`
-	CPUCursor      string   `query:"cpu_cursor" doc:"CPU cursor from previous request"`
-	ProcCursor     string   `query:"proc_cursor" doc:"Process cursor from previous request"`
-	NetRateCursor  string   `query:"net_rate_cursor" doc:"Network rate cursor from previous request"`
-	DiskRateCursor string   `query:"disk_rate_cursor" doc:"Disk rate cursor from previous request"`
+	GPUPciIds        []string `query:"gpu_pci_ids" example:"10de:2684,1002:164e" doc:"PCI IDs for GPU temperatures (when gpu module is requested)"`
+	CPUCursor        string   `query:"cpu_cursor" doc:"CPU cursor from previous request"`
+	ProcCursor       string   `query:"proc_cursor" doc:"Process cursor from previous request"`
+	NetRateCursor    string   `query:"net_rate_cursor" doc:"Network rate cursor from previous request"`
+	DiskRateCursor   string   `query:"disk_rate_cursor" doc:"Disk rate cursor from previous request"`
+	MountsCursor     string   `query:"mounts_cursor" doc:"Disk mounts cursor from previous request, enables fill-rate forecasts"`
+	PressureCursor   string   `query:"pressure_cursor" doc:"Pressure cursor from previous request"`
+	InterruptsCursor string   `query:"interrupts_cursor" doc:"Interrupts cursor from previous request"`
+	SystemCursor     string   `query:"system_cursor" doc:"System activity cursor from previous request"`
+	NUMACursor       string   `query:"numa_cursor" doc:"NUMA cursor from previous request"`
+	VMStatCursor     string   `query:"vmstat_cursor" doc:"vmstat cursor from previous request"`
+	NetStackCursor   string   `query:"netstack_cursor" doc:"TCP/IP stack cursor from previous request"`
+
+	NetworkFilterParams
 }
 
 type MetaResponse struct {
@@ -48,18 +58,30 @@ func (self *HandlerGroup) Meta(ctx context.Context, input *MetaInput) (*MetaResp
 	}
 
 	params := gops.MetaParams{
-		SortBy:         input.SortBy,
-		ProcLimit:      input.Limit,
-		EnableCPU:      !input.DisableProcCPU,
-		MergeChildren:  input.MergeChildren,
-		GPUPciIds:      input.GPUPciIds,
-		CPUCursor:      input.CPUCursor,
-		ProcCursor:     input.ProcCursor,
-		NetRateCursor:  input.NetRateCursor,
-		DiskRateCursor: input.DiskRateCursor,
+		SortBy:           input.SortBy,
+		ProcLimit:        input.Limit,
+		EnableCPU:        !input.DisableProcCPU,
+		MergeChildren:    input.MergeChildren,
+		GPUPciIds:        input.GPUPciIds,
+		CPUCursor:        input.CPUCursor,
+		ProcCursor:       input.ProcCursor,
+		NetRateCursor:    input.NetRateCursor,
+		DiskRateCursor:   input.DiskRateCursor,
+		MountsCursor:     input.MountsCursor,
+		PressureCursor:   input.PressureCursor,
+		InterruptsCursor: input.InterruptsCursor,
+		SystemCursor:     input.SystemCursor,
+		NUMACursor:       input.NUMACursor,
+		VMStatCursor:     input.VMStatCursor,
+		NetStackCursor:   input.NetStackCursor,
+	}
+
+	g, err := self.srv.Gops.WithScope(input.Scope).WithNetworkFilter(input.filter())
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
 	}
 
-	metaInfo, err := self.srv.Gops.GetMeta(ctx, modules, params)
+	metaInfo, err := g.GetMeta(ctx, modules, params)
 	if err != nil {
 		log.Error("Error getting meta info")
 		return nil, huma.Error400BadRequest(err.Error())
@@ -10,6 +10,7 @@ import (
 
 type NetRateInput struct {
 	Cursor string `query:"cursor" doc:"Base64 cursor for rate calculation"`
+	NetworkFilterParams
 }
 
 type NetRateResponse struct {
@@ -18,7 +19,12 @@ type NetRateResponse struct {
 
 // GET /net-rate
 func (self *HandlerGroup) NetRate(ctx context.Context, input *NetRateInput) (*NetRateResponse, error) {
-	netRateInfo, err := self.srv.Gops.GetNetworkRates(input.Cursor)
+	g, err := self.srv.Gops.WithNetworkFilter(input.filter())
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+
+	netRateInfo, err := g.GetNetworkRates(input.Cursor)
 	if err != nil {
 		log.Error("Error getting network rates")
 		return nil, huma.Error500InternalServerError("Unable to retrieve network rates")
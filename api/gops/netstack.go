@@ -0,0 +1,30 @@
+package gops_handler
+
+import (
+	"context"
+
+	"github.com/AvengeMedia/dgop/internal/log"
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type NetStackInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor for TCP/IP stack rate calculation"`
+}
+
+type NetStackResponse struct {
+	Body *models.NetStackResponse
+}
+
+// GET /netstack
+func (self *HandlerGroup) NetStack(ctx context.Context, input *NetStackInput) (*NetStackResponse, error) {
+	netstackInfo, err := self.srv.Gops.GetNetStack(input.Cursor)
+	if err != nil {
+		log.Error("Error getting netstack info")
+		return nil, huma.Error500InternalServerError("Unable to retrieve netstack info")
+	}
+
+	resp := &NetStackResponse{}
+	resp.Body = netstackInfo
+	return resp, nil
+}
@@ -3,12 +3,33 @@ package gops_handler
 import (
 	"context"
 
-	"github.com/AvengeMedia/dgop/api/server"
 	"github.com/AvengeMedia/dgop/internal/log"
 	"github.com/AvengeMedia/dgop/models"
 	"github.com/danielgtaylor/huma/v2"
 )
 
+// NetworkFilterParams narrows the interfaces reported, overlaid on the
+// server's own filter
+type NetworkFilterParams struct {
+	NetPreset  string   `query:"net_preset" enum:"default,all,physical" doc:"Interface preset: default hides container and VM plumbing, all keeps everything but loopback, physical keeps ethernet, wireless and modems"`
+	NetInclude []string `query:"net_include" example:"en*,wg*" doc:"Only interfaces matching these globs"`
+	NetExclude []string `query:"net_exclude" example:"tap*" doc:"Hide interfaces matching these globs, on top of the preset and the server's configured excludes"`
+	NetTypes   []string `query:"net_types" example:"ethernet,wireless" doc:"Only interfaces of these types (ethernet, wireless, wwan, infiniband, bridge, bond, tun, tunnel, ppp, virtual)"`
+}
+
+func (p NetworkFilterParams) filter() models.NetworkFilter {
+	return models.NetworkFilter{
+		Preset:  p.NetPreset,
+		Include: p.NetInclude,
+		Exclude: p.NetExclude,
+		Types:   p.NetTypes,
+	}
+}
+
+type NetworkInput struct {
+	NetworkFilterParams
+}
+
 type NetworkResponse struct {
 	Body struct {
 		Data []*models.NetworkInfo `json:"data"`
@@ -16,9 +37,13 @@ type NetworkResponse struct {
 }
 
 // GET /network
-func (self *HandlerGroup) Network(ctx context.Context, _ *server.EmptyInput) (*NetworkResponse, error) {
+func (self *HandlerGroup) Network(ctx context.Context, input *NetworkInput) (*NetworkResponse, error) {
+	g, err := self.srv.Gops.WithNetworkFilter(input.filter())
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
 
-	networkInfo, err := self.srv.Gops.GetNetworkInfo()
+	networkInfo, err := g.GetNetworkInfo()
 	if err != nil {
 		log.Error("Error getting Network info")
 		return nil, huma.Error500InternalServerError("Unable to retrieve Network info")
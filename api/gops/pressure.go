@@ -0,0 +1,30 @@
+package gops_handler
+
+import (
+	"context"
+
+	"github.com/AvengeMedia/dgop/internal/log"
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type PressureInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor for stall rate calculation"`
+}
+
+type PressureResponse struct {
+	Body *models.PressureResponse
+}
+
+// GET /pressure
+func (self *HandlerGroup) Pressure(ctx context.Context, input *PressureInput) (*PressureResponse, error) {
+	pressureInfo, err := self.srv.Gops.GetPressure(input.Cursor)
+	if err != nil {
+		log.Error("Error getting pressure info")
+		return nil, huma.Error500InternalServerError("Unable to retrieve pressure info")
+	}
+
+	resp := &PressureResponse{}
+	resp.Body = pressureInfo
+	return resp, nil
+}
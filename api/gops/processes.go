@@ -15,6 +15,8 @@ type ProcessInput struct {
 	DisableProcCPU bool            `query:"disable_proc_cpu" default:"false"`
 	Cursor         string          `query:"cursor" required:"false"`
 	MergeChildren  bool            `query:"merge_children" default:"true"`
+	Net            bool            `query:"net" default:"false" doc:"Attribute network traffic to processes; always on when sorting by net"`
+	Scope          gops.Scope      `query:"scope" doc:"Compute per-process CPU% and MEM% against the host or the cgroup's CPU and memory limits (auto uses the cgroup for the limits it sets; defaults to the server's --scope)"`
 }
 
 type ProcessResponse struct {
@@ -28,7 +30,7 @@ type ProcessResponse struct {
 func (self *HandlerGroup) Processes(ctx context.Context, input *ProcessInput) (*ProcessResponse, error) {
 	enableCPU := !input.DisableProcCPU
 
-	result, err := self.srv.Gops.GetProcessesWithCursor(input.SortBy, input.Limit, enableCPU, input.Cursor, input.MergeChildren)
+	result, err := self.srv.Gops.WithScope(input.Scope).WithProcessNet(input.Net).GetProcessesWithCursor(input.SortBy, input.Limit, enableCPU, input.Cursor, input.MergeChildren)
 	if err != nil {
 		log.Error("Error getting process info")
 		return nil, huma.Error500InternalServerError("Unable to retrieve process info")
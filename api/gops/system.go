@@ -3,12 +3,15 @@ package gops_handler
 import (
 	"context"
 
-	"github.com/AvengeMedia/dgop/api/server"
 	"github.com/AvengeMedia/dgop/internal/log"
 	"github.com/AvengeMedia/dgop/models"
 	"github.com/danielgtaylor/huma/v2"
 )
 
+type SystemInput struct {
+	Cursor string `query:"cursor" doc:"Base64 cursor for context switch, fork and interrupt rate calculation"`
+}
+
 type SystemResponse struct {
 	Body struct {
 		Data *models.SystemInfo `json:"data"`
@@ -16,9 +19,9 @@ type SystemResponse struct {
 }
 
 // GET /system
-func (self *HandlerGroup) System(ctx context.Context, _ *server.EmptyInput) (*SystemResponse, error) {
+func (self *HandlerGroup) System(ctx context.Context, input *SystemInput) (*SystemResponse, error) {
 
-	systemInfo, err := self.srv.Gops.GetSystemInfo()
+	systemInfo, err := self.srv.Gops.GetSystemInfoWithCursor(input.Cursor)
 	if err != nil {
 		log.Error("Error getting system info")
 		return nil, huma.Error500InternalServerError("Unable to retrieve system info")
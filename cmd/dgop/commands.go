@@ -3,8 +3,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AvengeMedia/dgop/gops"
 	"github.com/AvengeMedia/dgop/models"
@@ -38,6 +41,24 @@ var memoryCmd = &cobra.Command{
 	Long:  "Display memory usage information including RAM and swap statistics.",
 }
 
+var zramCmd = &cobra.Command{
+	Use:   "zram",
+	Short: "Get zram and zswap statistics",
+	Long:  "Display original, compressed and memory-used sizes and compression ratios for zram devices and zswap.",
+}
+
+var numaCmd = &cobra.Command{
+	Use:   "numa",
+	Short: "Get per-NUMA-node statistics",
+	Long:  "Display memory usage, CPUs and numa_hit/miss/foreign counters per NUMA node, with cursor-based miss rates.",
+}
+
+var vmstatCmd = &cobra.Command{
+	Use:   "vmstat",
+	Short: "Get paging and reclaim rates",
+	Long:  "Display page fault, swap-in/out, reclaim scan/steal, THP and OOM kill rates from /proc/vmstat with cursor-based sampling.",
+}
+
 var networkCmd = &cobra.Command{
 	Use:   "network",
 	Short: "Get network interface information",
@@ -56,12 +77,54 @@ var diskRateCmd = &cobra.Command{
 	Long:  "Display disk I/O rates with cursor-based sampling for accurate rate calculations.",
 }
 
+var pressureCmd = &cobra.Command{
+	Use:   "pressure",
+	Short: "Get pressure stall information",
+	Long:  "Display CPU, memory, I/O and IRQ pressure stall averages with cursor-based stall rates.",
+}
+
+var interruptsCmd = &cobra.Command{
+	Use:   "interrupts",
+	Short: "Get interrupt and softirq rates",
+	Long:  "Display the busiest hardware interrupts and softirqs and which CPUs handle them, with cursor-based rates.",
+}
+
 var diskCmd = &cobra.Command{
 	Use:   "disk",
 	Short: "Get disk information",
 	Long:  "Display disk usage statistics and mount information.",
 }
 
+var blockDevicesCmd = &cobra.Command{
+	Use:   "blockdevices",
+	Short: "Get block device tree",
+	Long:  "Display disks with model, serial, transport and queue settings, and the partitions, dm-crypt, LVM and md devices stacked on them.",
+}
+
+var smartCmd = &cobra.Command{
+	Use:   "smart",
+	Short: "Get drive SMART health",
+	Long:  "Display drive health, temperature, power-on hours, reallocated and pending sectors and NVMe wear via smartctl. Without smartctl, NVMe identity and temperature are read from sysfs.",
+}
+
+var storagePoolsCmd = &cobra.Command{
+	Use:   "storagepools",
+	Short: "Get RAID, btrfs and ZFS pool health",
+	Long:  "Display md arrays from /proc/mdstat, btrfs filesystems from sysfs and ZFS pools from zpool status with member state, error counters and resync/scrub progress.",
+}
+
+var connectionsCmd = &cobra.Command{
+	Use:   "connections",
+	Short: "Get sockets and their owning processes",
+	Long:  "Display TCP, UDP and unix sockets from /proc/net with local/remote address, state, queue sizes, UID and owning process, like ss -tupan. Without root only your own processes are resolved.",
+}
+
+var netstackCmd = &cobra.Command{
+	Use:   "netstack",
+	Short: "Get TCP/IP stack drop and error rates",
+	Long:  "Display TCP retransmits and resets, listen queue overflows, UDP buffer errors, ICMP unreachables and SYN cookies from /proc/net/snmp, snmp6 and netstat with cursor-based sampling, like nstat.",
+}
+
 var processesCmd = &cobra.Command{
 	Use:   "processes",
 	Short: "Get running processes",
@@ -80,6 +143,18 @@ var hardwareCmd = &cobra.Command{
 	Long:  "Display system hardware information including BIOS, motherboard, and CPU data.",
 }
 
+var sensorsCmd = &cobra.Command{
+	Use:   "sensors",
+	Short: "Get hardware sensors",
+	Long:  "Display every hwmon temperature, fan, voltage, current and power sensor with limits and alarms.",
+}
+
+var topologyCmd = &cobra.Command{
+	Use:   "topology",
+	Short: "Get CPU topology",
+	Long:  "Display CPU packages, physical cores, SMT siblings, caches, NUMA nodes and hybrid core types.",
+}
+
 var gpuCmd = &cobra.Command{
 	Use:   "gpu",
 	Short: "Get GPU information",
@@ -152,6 +227,54 @@ func runMemoryCommand(gopsUtil *gops.GopsUtil) error {
 	}
 
 	displayMemoryInfo(memInfo)
+
+	// Only worth showing when there is more than one node to compare
+	if numaInfo, err := gopsUtil.GetNUMA(""); err == nil && len(numaInfo.Nodes) > 1 {
+		fmt.Println()
+		displayNUMAInfo(numaInfo)
+	}
+	return nil
+}
+
+func runZramCommand(gopsUtil *gops.GopsUtil) error {
+	zramInfo, err := gopsUtil.GetZram()
+	if err != nil {
+		return fmt.Errorf("failed to get zram info: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(zramInfo)
+	}
+
+	displayZramInfo(zramInfo)
+	return nil
+}
+
+func runNUMACommand(gopsUtil *gops.GopsUtil) error {
+	numaInfo, err := gopsUtil.GetNUMA(numaCursor)
+	if err != nil {
+		return fmt.Errorf("failed to get NUMA info: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(numaInfo)
+	}
+
+	displayNUMAInfo(numaInfo)
+	return nil
+}
+
+func runVMStatCommand(gopsUtil *gops.GopsUtil) error {
+	vmstatInfo, err := gopsUtil.GetVMStat(vmstatCursor)
+	if err != nil {
+		return fmt.Errorf("failed to get vmstat: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(vmstatInfo)
+	}
+
+	displayVMStat(vmstatInfo)
 	return nil
 }
 
@@ -169,13 +292,88 @@ func runNetworkCommand(gopsUtil *gops.GopsUtil) error {
 	return nil
 }
 
+func runBlockDevicesCommand(gopsUtil *gops.GopsUtil) error {
+	devices, err := gopsUtil.GetBlockDevices()
+	if err != nil {
+		return fmt.Errorf("failed to get block devices: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(devices)
+	}
+
+	displayBlockDevices(devices)
+	return nil
+}
+
+func runSmartCommand(gopsUtil *gops.GopsUtil) error {
+	devices, err := gopsUtil.GetSmart()
+	if err != nil {
+		return fmt.Errorf("failed to get SMART health: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(devices)
+	}
+
+	displaySmart(devices)
+	return nil
+}
+
+func runConnectionsCommand(gopsUtil *gops.GopsUtil) error {
+	conns, err := gopsUtil.GetConnections(gops.ConnectionFilter{
+		Protocol: connProtocol,
+		State:    connState,
+		Port:     connPort,
+		PID:      connPID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get connections: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(conns)
+	}
+
+	displayConnections(conns)
+	return nil
+}
+
+func runNetStackCommand(gopsUtil *gops.GopsUtil) error {
+	netstackInfo, err := gopsUtil.GetNetStack(netstackCursor)
+	if err != nil {
+		return fmt.Errorf("failed to get netstack: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(netstackInfo)
+	}
+
+	displayNetStack(netstackInfo)
+	return nil
+}
+
+func runStoragePoolsCommand(gopsUtil *gops.GopsUtil) error {
+	pools, err := gopsUtil.GetStoragePools()
+	if err != nil {
+		return fmt.Errorf("failed to get storage pools: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(pools)
+	}
+
+	displayStoragePools(pools)
+	return nil
+}
+
 func runDiskCommand(gopsUtil *gops.GopsUtil) error {
 	diskInfo, err := gopsUtil.GetDiskInfo()
 	if err != nil {
 		return fmt.Errorf("failed to get disk info: %w", err)
 	}
 
-	diskMounts, err := gopsUtil.GetDiskMounts()
+	diskMounts, err := gopsUtil.GetDiskMountsWithCursor(diskMountsCursor)
 	if err != nil {
 		return fmt.Errorf("failed to get disk mounts: %w", err)
 	}
@@ -184,14 +382,17 @@ func runDiskCommand(gopsUtil *gops.GopsUtil) error {
 		data := struct {
 			Disk   []*models.DiskInfo      `json:"disk"`
 			Mounts []*models.DiskMountInfo `json:"mounts"`
+			Cursor string                  `json:"cursor"`
 		}{
 			Disk:   diskInfo,
-			Mounts: diskMounts,
+			Mounts: diskMounts.Mounts,
+			Cursor: diskMounts.Cursor,
 		}
 		return outputJSON(data)
 	}
 
-	displayDiskInfo(diskInfo, diskMounts)
+	displayDiskInfo(diskInfo, diskMounts.Mounts)
+	fmt.Printf("\nCursor: %s\n", diskMounts.Cursor)
 	return nil
 }
 
@@ -199,7 +400,7 @@ func runProcessesCommand(gopsUtil *gops.GopsUtil) error {
 	enableCPU := !disableProcCPU
 	sortBy := parseProcessSortBy(procSortBy, disableProcCPU)
 
-	result, err := gopsUtil.GetProcessesWithCursor(sortBy, procLimit, enableCPU, procCursor, mergeChildren)
+	result, err := gopsUtil.WithProcessNet(procNet).GetProcessesWithCursor(sortBy, procLimit, enableCPU, procCursor, mergeChildren)
 	if err != nil {
 		return fmt.Errorf("failed to get processes: %w", err)
 	}
@@ -213,7 +414,7 @@ func runProcessesCommand(gopsUtil *gops.GopsUtil) error {
 }
 
 func runSystemCommand(gopsUtil *gops.GopsUtil) error {
-	systemInfo, err := gopsUtil.GetSystemInfo()
+	systemInfo, err := gopsUtil.GetSystemInfoWithCursor(systemCursor)
 	if err != nil {
 		return fmt.Errorf("failed to get system info: %w", err)
 	}
@@ -240,6 +441,34 @@ func runHardwareCommand(gopsUtil *gops.GopsUtil) error {
 	return nil
 }
 
+func runSensorsCommand(gopsUtil *gops.GopsUtil) error {
+	chips, err := gopsUtil.GetSensors()
+	if err != nil {
+		return fmt.Errorf("failed to get sensors: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(chips)
+	}
+
+	displaySensors(chips)
+	return nil
+}
+
+func runTopologyCommand(gopsUtil *gops.GopsUtil) error {
+	topology, err := gopsUtil.GetCPUTopology()
+	if err != nil {
+		return fmt.Errorf("failed to get cpu topology: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(topology)
+	}
+
+	displayCPUTopology(topology)
+	return nil
+}
+
 func runGPUCommand(gopsUtil *gops.GopsUtil) error {
 	gpuInfo, err := gopsUtil.GetGPUInfo()
 	if err != nil {
@@ -270,15 +499,22 @@ func runGPUTempCommand(gopsUtil *gops.GopsUtil) error {
 
 func runMetaCommand(gopsUtil *gops.GopsUtil) error {
 	params := gops.MetaParams{
-		SortBy:         parseProcessSortBy(procSortBy, disableProcCPU),
-		ProcLimit:      procLimit,
-		EnableCPU:      !disableProcCPU,
-		MergeChildren:  mergeChildren,
-		GPUPciIds:      metaGPUPciIds,
-		CPUCursor:      cpuCursor,
-		ProcCursor:     procCursor,
-		NetRateCursor:  netRateCursor,
-		DiskRateCursor: diskRateCursor,
+		SortBy:           parseProcessSortBy(procSortBy, disableProcCPU),
+		ProcLimit:        procLimit,
+		EnableCPU:        !disableProcCPU,
+		MergeChildren:    mergeChildren,
+		GPUPciIds:        metaGPUPciIds,
+		CPUCursor:        cpuCursor,
+		ProcCursor:       procCursor,
+		NetRateCursor:    netRateCursor,
+		DiskRateCursor:   diskRateCursor,
+		MountsCursor:     diskMountsCursor,
+		PressureCursor:   pressureCursor,
+		InterruptsCursor: interruptsCursor,
+		SystemCursor:     systemCursor,
+		NUMACursor:       numaCursor,
+		VMStatCursor:     vmstatCursor,
+		NetStackCursor:   netstackCursor,
 	}
 
 	metaInfo, err := gopsUtil.GetMeta(context.Background(), metaModules, params)
@@ -354,7 +590,21 @@ func displaySystemInfo(info *models.SystemInfo) {
 		{"Boot Time:", info.BootTime},
 	}
 
+	if a := info.Activity; a != nil {
+		rows = append(rows,
+			[]string{"Context Switches:", fmt.Sprintf("%.0f/s (%d total)", a.ContextSwitchRate, a.ContextSwitches)},
+			[]string{"Forks:", fmt.Sprintf("%.1f/s (%d total)", a.ForkRate, a.Forks)},
+			[]string{"Interrupts:", fmt.Sprintf("%.0f/s (%d total)", a.InterruptRate, a.Interrupts)},
+			[]string{"Runnable Tasks:", strconv.Itoa(a.ProcsRunning)},
+			[]string{"Blocked Tasks:", strconv.Itoa(a.ProcsBlocked)},
+		)
+	}
+
 	printTable(rows)
+
+	if info.Cursor != "" {
+		fmt.Printf("\nCursor: %s\n", info.Cursor)
+	}
 }
 
 func displayCPUInfo(cpu *models.CPUInfo) {
@@ -368,6 +618,10 @@ func displayCPUInfo(cpu *models.CPUInfo) {
 		{"Usage:", fmt.Sprintf("%.1f%%", cpu.Usage)},
 	}
 
+	if cpu.Scope != "" {
+		rows = append(rows, []string{"Scope:", fmt.Sprintf("%s %s, usage relative to %.2f CPUs", cpu.Scope, cpu.Cgroup, cpu.CPULimit)})
+	}
+
 	if len(cpu.CoreUsage) > 0 {
 		coreUsageStr := ""
 		for i, usage := range cpu.CoreUsage {
@@ -379,9 +633,116 @@ func displayCPUInfo(cpu *models.CPUInfo) {
 		rows = append(rows, []string{"Core Usage:", coreUsageStr})
 	}
 
+	if b := cpu.Breakdown; b != nil {
+		rows = append(rows, []string{"Breakdown:", fmt.Sprintf("user %.1f%%  nice %.1f%%  sys %.1f%%  iowait %.1f%%  irq %.1f%%  softirq %.1f%%  steal %.1f%%",
+			b.User, b.Nice, b.System, b.Iowait, b.Irq, b.Softirq, b.Steal)})
+	}
+
+	if len(cpu.CoreBreakdown) > 0 {
+		coreBreakdownStr := ""
+		for i, b := range cpu.CoreBreakdown {
+			if i > 0 && i%2 == 0 {
+				coreBreakdownStr += "\n              "
+			}
+			coreBreakdownStr += fmt.Sprintf("%d: usr %4.1f sys %4.1f io %4.1f irq %4.1f st %4.1f  ",
+				i, b.User+b.Nice, b.System, b.Iowait, b.Irq+b.Softirq, b.Steal)
+		}
+		rows = append(rows, []string{"Core Times:", coreBreakdownStr})
+	}
+
+	if cpu.FreqDriver != "" {
+		rows = append(rows, []string{"Freq Driver:", cpu.FreqDriver})
+	}
+
+	if len(cpu.CoreFreqs) > 0 {
+		if governor := summarizeCoreFreqField(cpu.CoreFreqs, func(f models.CPUCoreFreq) string { return f.Governor }); governor != "" {
+			rows = append(rows, []string{"Governor:", governor})
+		}
+		if epp := summarizeCoreFreqField(cpu.CoreFreqs, func(f models.CPUCoreFreq) string { return f.EPP }); epp != "" {
+			rows = append(rows, []string{"EPP:", epp})
+		}
+
+		coreFreqStr := ""
+		for i, f := range cpu.CoreFreqs {
+			if i > 0 && i%4 == 0 {
+				coreFreqStr += "\n              "
+			}
+			coreFreqStr += fmt.Sprintf("%d: %4.0f MHz  ", f.Core, f.Current)
+		}
+		rows = append(rows, []string{"Core Freq:", coreFreqStr})
+
+		limitsStr := ""
+		for i, f := range cpu.CoreFreqs {
+			if i > 0 && i%4 == 0 {
+				limitsStr += "\n              "
+			}
+			limitsStr += fmt.Sprintf("%d: %.0f-%.0f  ", f.Core, f.Min, f.Max)
+		}
+		rows = append(rows, []string{"Freq Limits:", limitsStr})
+	}
+
+	for _, t := range cpu.PackageThrottle {
+		rows = append(rows, []string{fmt.Sprintf("Pkg %d Throttle:", t.ID), formatThrottle(t)})
+	}
+
+	if len(cpu.CoreThrottle) > 0 {
+		throttleStr := ""
+		shown := 0
+		for _, t := range cpu.CoreThrottle {
+			// Only list cores that have throttled at all to keep the table short
+			if t.Count == 0 {
+				continue
+			}
+			if shown > 0 && shown%2 == 0 {
+				throttleStr += "\n              "
+			}
+			throttleStr += fmt.Sprintf("%d: %s  ", t.ID, formatThrottle(t))
+			shown++
+		}
+		if throttleStr == "" {
+			throttleStr = "none"
+		}
+		rows = append(rows, []string{"Core Throttle:", throttleStr})
+	}
+
 	printTable(rows)
 }
 
+func formatThrottle(t models.CPUThrottle) string {
+	return fmt.Sprintf("%d times / %d ms last interval (%d / %d ms total)",
+		t.IntervalCount, t.IntervalTimeMs, t.Count, t.TimeMs)
+}
+
+// summarizeCoreFreqField collapses a per-core string to a single value when
+// every core agrees, otherwise lists the distinct values with their counts.
+func summarizeCoreFreqField(freqs []models.CPUCoreFreq, field func(models.CPUCoreFreq) string) string {
+	counts := make(map[string]int)
+	var order []string
+	for _, f := range freqs {
+		v := field(f)
+		if v == "" {
+			continue
+		}
+		if counts[v] == 0 {
+			order = append(order, v)
+		}
+		counts[v]++
+	}
+
+	switch len(order) {
+	case 0:
+		return ""
+	case 1:
+		return order[0]
+	}
+
+	parts := make([]string, 0, len(order))
+	for _, v := range order {
+		parts = append(parts, fmt.Sprintf("%s (%d)", v, counts[v]))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func displayMemoryInfo(mem *models.MemoryInfo) {
 	fmt.Println(titleStyle.Render("MEMORY"))
 
@@ -396,12 +757,186 @@ func displayMemoryInfo(mem *models.MemoryInfo) {
 		{"Cached:", fmt.Sprintf("%.2f GB", toGB(mem.Cached))},
 	}
 
+	if mem.Scope != "" {
+		rows = append(rows, []string{"Scope:", fmt.Sprintf("%s %s", mem.Scope, mem.Cgroup)})
+	}
+
 	if mem.SwapTotal > 0 {
 		rows = append(rows, []string{"Swap Total:", fmt.Sprintf("%.2f GB", toGB(mem.SwapTotal))})
 		rows = append(rows, []string{"Swap Used:", fmt.Sprintf("%.2f GB", toGB(mem.SwapTotal-mem.SwapFree))})
 	}
 
 	printTable(rows)
+
+	if memoryVerbose && mem.Details != nil {
+		fmt.Println()
+		displayMemoryDetails(mem.Details)
+	}
+}
+
+func displayZramInfo(zram *models.ZramInfo) {
+	fmt.Println(titleStyle.Render("ZRAM / ZSWAP"))
+
+	if len(zram.Devices) == 0 {
+		fmt.Println(valueStyle.Render("  No zram devices configured"))
+	}
+
+	for i, dev := range zram.Devices {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Println(keyStyle.Render(fmt.Sprintf("Device: %s", dev.Device)))
+
+		rows := [][]string{
+			{"Algorithm:", dev.Algorithm},
+			{"Disk Size:", formatBytes(dev.DiskSize)},
+			{"Original:", formatBytes(dev.OrigData)},
+			{"Compressed:", formatBytes(dev.ComprData)},
+			{"Memory Used:", fmt.Sprintf("%s (peak %s)", formatBytes(dev.MemUsed), formatBytes(dev.MemUsedMax))},
+			{"Ratio:", fmt.Sprintf("%.2fx", dev.CompressionRatio)},
+		}
+		if dev.MemLimit > 0 {
+			rows = append(rows, []string{"Memory Limit:", formatBytes(dev.MemLimit)})
+		}
+
+		printTable(rows)
+	}
+
+	if z := zram.Zswap; z != nil {
+		fmt.Println()
+		fmt.Println(keyStyle.Render("zswap:"))
+
+		state := "disabled"
+		if z.Enabled {
+			state = "enabled"
+		}
+		compressor := z.Compressor
+		if z.Zpool != "" {
+			compressor += "/" + z.Zpool
+		}
+
+		rows := [][]string{
+			{"State:", fmt.Sprintf("%s, %s, max pool %d%%", state, compressor, z.MaxPoolPercent)},
+			{"Original:", formatBytes(z.OrigData)},
+			{"Pool Size:", formatBytes(z.PoolSize)},
+			{"Ratio:", fmt.Sprintf("%.2fx", z.CompressionRatio)},
+		}
+		if z.WrittenBackPages > 0 || z.PoolLimitHit > 0 {
+			rows = append(rows, []string{"Written Back:", fmt.Sprintf("%d pages (pool limit hit %d times)", z.WrittenBackPages, z.PoolLimitHit)})
+		}
+
+		printTable(rows)
+	}
+}
+
+func displayNUMAInfo(numa *models.NUMAResponse) {
+	fmt.Println(titleStyle.Render("NUMA"))
+
+	kb := func(v uint64) string { return formatBytes(v * 1024) }
+
+	for i, node := range numa.Nodes {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Println(keyStyle.Render(fmt.Sprintf("Node %d:", node.Node)))
+
+		rows := [][]string{
+			{"CPUs:", formatCPUList(node.CPUs)},
+			{"Memory:", fmt.Sprintf("%s / %s (%.1f%%), %s free", kb(node.Used), kb(node.Total), node.UsedPercent, kb(node.Free))},
+			{"File / Anon:", fmt.Sprintf("%s / %s", kb(node.FilePages), kb(node.AnonPages))},
+			{"Hit / Miss:", fmt.Sprintf("%d / %d (%.2f%% miss), foreign %d", node.NumaHit, node.NumaMiss, node.MissPercent, node.NumaForeign)},
+			{"Local / Other:", fmt.Sprintf("%d / %d", node.LocalNode, node.OtherNode)},
+		}
+		if node.HitRate > 0 || node.MissRate > 0 || node.ForeignRate > 0 {
+			rows = append(rows, []string{"Rates:", fmt.Sprintf("hit %.0f/s, miss %.0f/s, foreign %.0f/s", node.HitRate, node.MissRate, node.ForeignRate)})
+		}
+
+		printTable(rows)
+	}
+}
+
+func displayVMStat(vm *models.VMStatResponse) {
+	fmt.Println(titleStyle.Render("VMSTAT"))
+
+	c, r := vm.Counters, vm.Rates
+	stat := func(total uint64, rate float64) string {
+		return fmt.Sprintf("%d (%.1f/s)", total, rate)
+	}
+
+	rows := [][]string{
+		{"Page Faults:", stat(c.PgFault, r.PgFault)},
+		{"Major Faults:", stat(c.PgMajFault, r.PgMajFault)},
+		{"Page In/Out:", fmt.Sprintf("%s / %s", stat(c.PgpgIn, r.PgpgIn), stat(c.PgpgOut, r.PgpgOut))},
+		{"Swap In/Out:", fmt.Sprintf("%s / %s", stat(c.PswpIn, r.PswpIn), stat(c.PswpOut, r.PswpOut))},
+		{"Scan kswapd:", fmt.Sprintf("%s, stolen %s", stat(c.PgScanKswapd, r.PgScanKswapd), stat(c.PgStealKswapd, r.PgStealKswapd))},
+		{"Scan Direct:", fmt.Sprintf("%s, stolen %s", stat(c.PgScanDirect, r.PgScanDirect), stat(c.PgStealDirect, r.PgStealDirect))},
+		{"Alloc Stalls:", stat(c.AllocStall, r.AllocStall)},
+		{"THP Faults:", fmt.Sprintf("%s, fallback %s", stat(c.ThpFaultAlloc, r.ThpFaultAlloc), stat(c.ThpFaultFallback, r.ThpFaultFallback))},
+		{"THP Collapse:", fmt.Sprintf("%s, failed %s", stat(c.ThpCollapseAlloc, r.ThpCollapseAlloc), stat(c.ThpCollapseFail, r.ThpCollapseFail))},
+		{"OOM Kills:", fmt.Sprintf("%d (%d since last sample)", c.OomKill, vm.OomKills)},
+	}
+	if vm.ReclaimEfficiency > 0 {
+		rows = append(rows, []string{"Reclaim Eff.:", fmt.Sprintf("%.1f%%", vm.ReclaimEfficiency)})
+	}
+
+	printTable(rows)
+}
+
+func displayMemoryDetails(d *models.MemoryDetails) {
+	fmt.Println(keyStyle.Render("Details:"))
+
+	kb := func(v uint64) string { return formatBytes(v * 1024) }
+
+	rows := [][]string{
+		{"Active:", fmt.Sprintf("%s (anon %s, file %s)", kb(d.Active), kb(d.ActiveAnon), kb(d.ActiveFile))},
+		{"Inactive:", fmt.Sprintf("%s (anon %s, file %s)", kb(d.Inactive), kb(d.InactiveAnon), kb(d.InactiveFile))},
+		{"Anon Pages:", kb(d.AnonPages)},
+		{"Mapped:", kb(d.Mapped)},
+		{"Shmem:", kb(d.Shmem)},
+		{"Unevictable:", fmt.Sprintf("%s (mlocked %s)", kb(d.Unevictable), kb(d.Mlocked))},
+		{"Dirty:", kb(d.Dirty)},
+		{"Writeback:", kb(d.Writeback)},
+		{"Slab:", fmt.Sprintf("%s (reclaimable %s, unreclaimable %s)", kb(d.Slab), kb(d.SReclaimable), kb(d.SUnreclaim))},
+		{"Kernel Stack:", kb(d.KernelStack)},
+		{"Page Tables:", kb(d.PageTables)},
+		{"Per-CPU:", kb(d.Percpu)},
+		{"Vmalloc Used:", kb(d.VmallocUsed)},
+		{"Swap Cached:", kb(d.SwapCached)},
+	}
+
+	commit := fmt.Sprintf("%s of %s limit", kb(d.CommittedAS), kb(d.CommitLimit))
+	if d.CommitLimit > 0 {
+		commit += fmt.Sprintf(" (%.1f%%)", float64(d.CommittedAS)/float64(d.CommitLimit)*100)
+	}
+	rows = append(rows, []string{"Committed:", commit})
+
+	rows = append(rows, []string{"THP:", fmt.Sprintf("anon %s, shmem %s, file %s",
+		kb(d.AnonHugePages), kb(d.ShmemHugePages), kb(d.FileHugePages))})
+
+	if hp := d.HugePages; hp.Total > 0 {
+		rows = append(rows, []string{"HugePages:", fmt.Sprintf("%d total, %d free, %d reserved, %d surplus (%s each, %s hugetlb)",
+			hp.Total, hp.Free, hp.Reserved, hp.Surplus, kb(hp.PageSize), kb(hp.Hugetlb))})
+	}
+
+	if ksm := d.KSM; ksm != nil {
+		state := "stopped"
+		if ksm.Running {
+			state = "running"
+		}
+		rows = append(rows, []string{"KSM:", fmt.Sprintf("%s, %d shared, %d sharing, %d unshared, saved %s, profit %s",
+			state, ksm.PagesShared, ksm.PagesSharing, ksm.PagesUnshared, kb(ksm.Saved), formatSignedKB(ksm.GeneralProfit))})
+	}
+
+	printTable(rows)
+}
+
+func formatSignedKB(v int64) string {
+	if v < 0 {
+		return "-" + formatBytes(uint64(-v)*1024)
+	}
+	return formatBytes(uint64(v) * 1024)
 }
 
 func displayNetworkInfo(interfaces []*models.NetworkInfo) {
@@ -414,15 +949,51 @@ func displayNetworkInfo(interfaces []*models.NetworkInfo) {
 
 		fmt.Println(keyStyle.Render(fmt.Sprintf("Interface: %s", iface.Name)))
 
-		rows := [][]string{
-			{"Bytes Received:", formatBytes(iface.Rx)},
-			{"Bytes Sent:", formatBytes(iface.Tx)},
+		var rows [][]string
+		if iface.Type != "" || iface.Driver != "" {
+			rows = append(rows, []string{"Type:", strings.TrimSpace(iface.Type + " " + formatDriver(iface.Driver))})
+		}
+		if iface.OperState != "" {
+			state := iface.OperState
+			if !iface.Carrier {
+				state += ", no carrier"
+			}
+			rows = append(rows, []string{"State:", state})
+		}
+		if iface.Speed > 0 {
+			rows = append(rows, []string{"Link:", strings.TrimSpace(fmt.Sprintf("%d Mb/s %s", iface.Speed, iface.Duplex))})
+		}
+		if w := iface.Wireless; w != nil {
+			rows = append(rows, []string{"Signal:", fmt.Sprintf("%.0f dBm (quality %.0f)", w.Signal, w.Quality)})
+		}
+		if len(iface.IPv4) > 0 {
+			rows = append(rows, []string{"IPv4:", strings.Join(iface.IPv4, ", ")})
+		}
+		if len(iface.IPv6) > 0 {
+			rows = append(rows, []string{"IPv6:", strings.Join(iface.IPv6, ", ")})
+		}
+		if iface.MAC != "" {
+			rows = append(rows, []string{"MAC:", iface.MAC})
 		}
+		if iface.MTU > 0 {
+			rows = append(rows, []string{"MTU:", fmt.Sprintf("%d", iface.MTU)})
+		}
+		rows = append(rows,
+			[]string{"Bytes Received:", formatBytes(iface.Rx)},
+			[]string{"Bytes Sent:", formatBytes(iface.Tx)},
+		)
 
 		printTable(rows)
 	}
 }
 
+func formatDriver(driver string) string {
+	if driver == "" {
+		return ""
+	}
+	return "(" + driver + ")"
+}
+
 func displayDiskInfo(disks []*models.DiskInfo, mounts []*models.DiskMountInfo) {
 	fmt.Println(titleStyle.Render("DISK"))
 
@@ -455,26 +1026,328 @@ func displayDiskInfo(disks []*models.DiskInfo, mounts []*models.DiskMountInfo) {
 				valueStyle.Render(mount.FSType),
 				valueStyle.Render(mount.Used+" ("+mount.Percent+")"),
 				valueStyle.Render(mount.Avail))
+			if len(mount.Disks) > 0 {
+				fmt.Printf("    on %s\n", valueStyle.Render(strings.Join(mount.Disks, ", ")))
+			}
+			if mount.FullIn > 0 {
+				fmt.Printf("    growing %s, %s\n",
+					valueStyle.Render(formatRate(mount.GrowthRate)),
+					valueStyle.Render(fmt.Sprintf("%s full in ~%s", mount.Mount, formatApproxDuration(mount.FullIn))))
+			}
+			if mount.InodesFullIn > 0 {
+				fmt.Printf("    inodes %.0f%% used, %s\n",
+					mount.InodesUsedPercent,
+					valueStyle.Render(fmt.Sprintf("out of inodes in ~%s", formatApproxDuration(mount.InodesFullIn))))
+			}
 		}
 	}
 }
 
-func displayProcesses(processes []*models.ProcessInfo) {
-	fmt.Println(titleStyle.Render(fmt.Sprintf("PROCESSES (%d)", len(processes))))
+// formatApproxDuration rounds to the largest sensible unit, e.g. "3h" or "2d"
+func formatApproxDuration(seconds float64) string {
+	switch {
+	case seconds < 60:
+		return fmt.Sprintf("%.0fs", seconds)
+	case seconds < 3600:
+		return fmt.Sprintf("%.0fm", seconds/60)
+	case seconds < 86400:
+		return fmt.Sprintf("%.0fh", seconds/3600)
+	default:
+		return fmt.Sprintf("%.0fd", seconds/86400)
+	}
+}
 
-	// Header
-	header := fmt.Sprintf("%-8s %-8s %-20s %-8s %-8s %s",
-		"PID", "PPID", "COMMAND", "CPU%", "MEM%", "FULL COMMAND")
-	fmt.Println(keyStyle.Render(header))
-	fmt.Println(strings.Repeat("─", 80))
+func displayBlockDevices(devices []*models.BlockDevice) {
+	fmt.Println(titleStyle.Render("BLOCK DEVICES"))
 
-	for _, proc := range processes {
-		row := fmt.Sprintf("%-8d %-8d %-20s %-8.1f %-8.1f %s",
-			proc.PID,
+	if len(devices) == 0 {
+		fmt.Println(valueStyle.Render("  No block devices found"))
+		return
+	}
+
+	header := fmt.Sprintf("%-24s %-6s %10s %-8s %s", "NAME", "TYPE", "SIZE", "TRAN", "DETAILS")
+	fmt.Println(keyStyle.Render(header))
+
+	var walk func(dev *models.BlockDevice, prefix string, last, root bool)
+	walk = func(dev *models.BlockDevice, prefix string, last, root bool) {
+		branch, childPrefix := "", ""
+		if !root {
+			branch, childPrefix = "├─", prefix+"│ "
+			if last {
+				branch, childPrefix = "└─", prefix+"  "
+			}
+		}
+
+		fmt.Printf("%-24s %-6s %10s %-8s %s\n",
+			prefix+branch+dev.Name, dev.Type, formatBytes(dev.Size), dev.Transport, formatBlockDeviceDetails(dev))
+
+		for i, child := range dev.Children {
+			walk(child, childPrefix, i == len(dev.Children)-1, false)
+		}
+	}
+
+	for _, dev := range devices {
+		walk(dev, "", true, true)
+	}
+}
+
+func formatBlockDeviceDetails(dev *models.BlockDevice) string {
+	var parts []string
+	if dev.Model != "" {
+		parts = append(parts, strings.TrimSpace(dev.Vendor+" "+dev.Model))
+	}
+	if dev.Serial != "" {
+		parts = append(parts, "s/n "+dev.Serial)
+	}
+	if dev.DMName != "" {
+		parts = append(parts, dev.DMName)
+	}
+	if dev.RaidLevel != "" {
+		parts = append(parts, dev.RaidLevel)
+	}
+	if dev.Partition == 0 && dev.Type == "disk" {
+		media := "ssd"
+		if dev.Rotational {
+			media = "hdd"
+		}
+		if dev.Removable {
+			media += ", removable"
+		}
+		parts = append(parts, fmt.Sprintf("%s, %d/%dB sectors", media, dev.LogicalSectorSize, dev.PhysicalSectorSize))
+		if dev.Scheduler != "" {
+			parts = append(parts, dev.Scheduler)
+		}
+	}
+	if dev.ReadOnly {
+		parts = append(parts, "ro")
+	}
+	if len(dev.Mountpoints) > 0 {
+		parts = append(parts, strings.Join(dev.Mountpoints, ", "))
+	}
+	return strings.Join(parts, "  ")
+}
+
+func displaySmart(devices []*models.SmartDevice) {
+	fmt.Println(titleStyle.Render("SMART HEALTH"))
+
+	if len(devices) == 0 {
+		fmt.Println(valueStyle.Render("  No drives found"))
+		return
+	}
+
+	for i, dev := range devices {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		title := dev.Path
+		if dev.Model != "" {
+			title += " (" + dev.Model + ")"
+		}
+		fmt.Println(keyStyle.Render(title))
+
+		rows := [][]string{
+			{"Health:", strings.ToUpper(dev.Health)},
+			{"Source:", dev.Source},
+		}
+		if dev.Serial != "" {
+			rows = append(rows, []string{"Serial:", dev.Serial})
+		}
+		if dev.Firmware != "" {
+			rows = append(rows, []string{"Firmware:", dev.Firmware})
+		}
+		if dev.Capacity > 0 {
+			rows = append(rows, []string{"Capacity:", formatBytes(dev.Capacity)})
+		}
+		if dev.Temperature > 0 {
+			rows = append(rows, []string{"Temperature:", fmt.Sprintf("%.0f°C", dev.Temperature)})
+		}
+		if dev.PowerOnHours > 0 {
+			rows = append(rows, []string{"Power On:", fmt.Sprintf("%d hours (%d cycles)", dev.PowerOnHours, dev.PowerCycles)})
+		}
+		if dev.Source == "smartctl" && dev.Error == "" && dev.Skipped == "" {
+			if dev.PercentageUsed != nil {
+				rows = append(rows,
+					[]string{"Wear:", fmt.Sprintf("%d%% used, %d%% spare", *dev.PercentageUsed, *dev.AvailableSpare)},
+					[]string{"Media Errors:", strconv.FormatUint(dev.MediaErrors, 10)},
+					[]string{"Unsafe Shutdowns:", strconv.FormatUint(dev.UnsafeShutdowns, 10)},
+					[]string{"Data Read/Written:", fmt.Sprintf("%s / %s", formatBytes(dev.DataRead), formatBytes(dev.DataWritten))},
+				)
+				if dev.CriticalWarning != 0 {
+					rows = append(rows, []string{"Critical Warning:", fmt.Sprintf("0x%02x", dev.CriticalWarning)})
+				}
+			} else {
+				rows = append(rows, []string{"Bad Sectors:", fmt.Sprintf("%d reallocated, %d pending, %d uncorrectable",
+					dev.ReallocatedSectors, dev.PendingSectors, dev.Uncorrectable)})
+			}
+		}
+		if dev.Skipped != "" {
+			rows = append(rows, []string{"Skipped:", "drive in " + dev.Skipped + ", not woken up"})
+		}
+		if dev.Error != "" {
+			rows = append(rows, []string{"Error:", dev.Error})
+		}
+
+		printTable(rows)
+	}
+}
+
+func displayStoragePools(pools []*models.StoragePool) {
+	fmt.Println(titleStyle.Render("STORAGE POOLS"))
+
+	if len(pools) == 0 {
+		fmt.Println(valueStyle.Render("  No md arrays, btrfs filesystems or ZFS pools found"))
+		return
+	}
+
+	for i, pool := range pools {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		title := fmt.Sprintf("%s (%s", pool.Name, pool.Kind)
+		if pool.Level != "" {
+			title += " " + pool.Level
+		}
+		fmt.Println(keyStyle.Render(title + ")"))
+
+		rows := [][]string{
+			{"Status:", fmt.Sprintf("%s (%s)", strings.ToUpper(pool.Status), pool.State)},
+		}
+		if pool.Size > 0 {
+			rows = append(rows, []string{"Size:", formatBytes(pool.Size)})
+		}
+		if pool.Devices > 0 {
+			rows = append(rows, []string{"Devices:", fmt.Sprintf("%d/%d working", pool.Working, pool.Devices)})
+		}
+		if pool.Operation != "" {
+			op := fmt.Sprintf("%s %.1f%%", pool.Operation, pool.Progress)
+			if pool.Speed > 0 {
+				op += fmt.Sprintf(" at %s/s", formatBytes(pool.Speed))
+			}
+			if pool.ETASeconds > 0 {
+				op += fmt.Sprintf(", %s left", (time.Duration(pool.ETASeconds) * time.Second).String())
+			}
+			rows = append(rows, []string{"Operation:", op})
+		}
+		if pool.Errors > 0 {
+			rows = append(rows, []string{"Errors:", strconv.FormatUint(pool.Errors, 10)})
+		}
+		if pool.Message != "" {
+			rows = append(rows, []string{"Message:", pool.Message})
+		}
+
+		for _, member := range pool.Members {
+			value := member.State
+			if errs := member.ReadErrors + member.WriteErrors + member.ChecksumErrors; errs > 0 {
+				value += fmt.Sprintf(" (read %d, write %d, cksum %d)", member.ReadErrors, member.WriteErrors, member.ChecksumErrors)
+			}
+			rows = append(rows, []string{"  " + member.Name + ":", value})
+		}
+
+		printTable(rows)
+	}
+}
+
+func displayConnections(conns *models.ConnectionsResponse) {
+	fmt.Println(titleStyle.Render(fmt.Sprintf("CONNECTIONS (%d)", len(conns.Connections))))
+
+	if summary := conns.Summary; summary != nil && len(summary.TCPStates) > 0 {
+		states := make([]string, 0, len(summary.TCPStates))
+		for state := range summary.TCPStates {
+			states = append(states, state)
+		}
+		sort.Strings(states)
+
+		parts := make([]string, 0, len(states))
+		for _, state := range states {
+			parts = append(parts, fmt.Sprintf("%s %d", state, summary.TCPStates[state]))
+		}
+		fmt.Printf("  %s %s\n", keyStyle.Render("TCP:"), valueStyle.Render(strings.Join(parts, ", ")))
+	}
+
+	header := fmt.Sprintf("%-6s %-12s %-7s %-7s %-28s %-28s %s",
+		"PROTO", "STATE", "RECV-Q", "SEND-Q", "LOCAL", "PEER", "PROCESS")
+	fmt.Println(keyStyle.Render(header))
+	fmt.Println(strings.Repeat("─", 80))
+
+	for _, conn := range conns.Connections {
+		local := formatEndpoint(conn.LocalAddr, conn.LocalPort)
+		peer := formatEndpoint(conn.RemoteAddr, conn.RemotePort)
+		if conn.Protocol == "unix" {
+			local, peer = conn.Path, "*"
+			if local == "" {
+				local = "*"
+			}
+		}
+
+		process := ""
+		if conn.PID != 0 {
+			process = fmt.Sprintf("%s (%d)", conn.Command, conn.PID)
+		}
+
+		row := fmt.Sprintf("%-6s %-12s %-7d %-7d %-28s %-28s %s",
+			conn.Protocol,
+			conn.State,
+			conn.RxQueue,
+			conn.TxQueue,
+			truncateString(local, 28),
+			truncateString(peer, 28),
+			process)
+		fmt.Println(valueStyle.Render(row))
+	}
+}
+
+func displayNetStack(ns *models.NetStackResponse) {
+	fmt.Println(titleStyle.Render("NETSTACK"))
+
+	c, r := ns.Counters, ns.Rates
+	stat := func(total uint64, rate float64) string {
+		return fmt.Sprintf("%d (%.1f/s)", total, rate)
+	}
+
+	rows := [][]string{
+		{"Retransmits:", fmt.Sprintf("%s of %s segs (%.2f%%)", stat(c.TcpRetransSegs, r.TcpRetransSegs), stat(c.TcpOutSegs, r.TcpOutSegs), ns.RetransmitPercent)},
+		{"TCP Resets:", fmt.Sprintf("estab %s, sent %s", stat(c.TcpEstabResets, r.TcpEstabResets), stat(c.TcpOutRsts, r.TcpOutRsts))},
+		{"Failed Opens:", stat(c.TcpAttemptFails, r.TcpAttemptFails)},
+		{"TCP In Errors:", stat(c.TcpInErrs, r.TcpInErrs)},
+		{"Listen Queue:", fmt.Sprintf("overflows %s, drops %s", stat(c.ListenOverflows, r.ListenOverflows), stat(c.ListenDrops, r.ListenDrops))},
+		{"UDP Errors:", fmt.Sprintf("%s, rcvbuf %s, sndbuf %s", stat(c.UdpInErrors, r.UdpInErrors), stat(c.UdpRcvbufErrors, r.UdpRcvbufErrors), stat(c.UdpSndbufErrors, r.UdpSndbufErrors))},
+		{"UDP No Port:", stat(c.UdpNoPorts, r.UdpNoPorts)},
+		{"ICMP Unreach:", fmt.Sprintf("in %s, out %s", stat(c.IcmpInDestUnreachs, r.IcmpInDestUnreachs), stat(c.IcmpOutDestUnreachs, r.IcmpOutDestUnreachs))},
+		{"SYN Cookies:", fmt.Sprintf("sent %s, recv %s, failed %s", stat(c.SyncookiesSent, r.SyncookiesSent), stat(c.SyncookiesRecv, r.SyncookiesRecv), stat(c.SyncookiesFailed, r.SyncookiesFailed))},
+	}
+
+	printTable(rows)
+}
+
+func formatEndpoint(addr string, port int) string {
+	if port == 0 {
+		return net.JoinHostPort(addr, "*")
+	}
+	return net.JoinHostPort(addr, strconv.Itoa(port))
+}
+
+func displayProcesses(processes []*models.ProcessInfo) {
+	fmt.Println(titleStyle.Render(fmt.Sprintf("PROCESSES (%d)", len(processes))))
+
+	// Header
+	header := fmt.Sprintf("%-8s %-8s %-20s %-8s %-8s %-11s %-11s %-11s %-11s %s",
+		"PID", "PPID", "COMMAND", "CPU%", "MEM%", "READ/s", "WRITE/s", "NET RX/s", "NET TX/s", "FULL COMMAND")
+	fmt.Println(keyStyle.Render(header))
+	fmt.Println(strings.Repeat("─", 80))
+
+	for _, proc := range processes {
+		row := fmt.Sprintf("%-8d %-8d %-20s %-8.1f %-8.1f %-11s %-11s %-11s %-11s %s",
+			proc.PID,
 			proc.PPID,
 			truncateString(proc.Command, 20),
 			proc.CPU,
 			proc.MemoryPercent,
+			formatBytesFloat(proc.IOReadRate),
+			formatBytesFloat(proc.IOWriteRate),
+			formatBytesFloat(proc.NetRxRate),
+			formatBytesFloat(proc.NetTxRate),
 			truncateString(proc.FullCommand, 30))
 		fmt.Println(valueStyle.Render(row))
 	}
@@ -528,6 +1401,140 @@ func displayHardwareInfo(hardware *models.SystemHardware) {
 	printTable(rows)
 }
 
+func displaySensors(chips []*models.SensorChip) {
+	fmt.Println(titleStyle.Render("SENSORS"))
+
+	for i, chip := range chips {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Println(keyStyle.Render(fmt.Sprintf("%s (%s)", chip.Name, chip.Hwmon)))
+
+		var rows [][]string
+		for _, s := range chip.Sensors {
+			name := s.Channel
+			if s.Label != "" {
+				name = s.Label
+			}
+
+			value := formatSensorValue(s.Input, s.Unit)
+			var limits []string
+			if s.Min != nil {
+				limits = append(limits, "min "+formatSensorValue(*s.Min, s.Unit))
+			}
+			if s.Max != nil {
+				limits = append(limits, "max "+formatSensorValue(*s.Max, s.Unit))
+			}
+			if s.Crit != nil {
+				limits = append(limits, "crit "+formatSensorValue(*s.Crit, s.Unit))
+			}
+			if len(limits) > 0 {
+				value += " (" + strings.Join(limits, ", ") + ")"
+			}
+			if len(s.Alarms) > 0 {
+				value += " ALARM: " + strings.Join(s.Alarms, ",")
+			}
+
+			rows = append(rows, []string{name + ":", value})
+		}
+
+		printTable(rows)
+	}
+}
+
+func formatSensorValue(value float64, unit string) string {
+	switch unit {
+	case "RPM":
+		return fmt.Sprintf("%.0f %s", value, unit)
+	case "°C":
+		return fmt.Sprintf("%.1f%s", value, unit)
+	default:
+		return fmt.Sprintf("%.2f %s", value, unit)
+	}
+}
+
+func displayCPUTopology(topology *models.CPUTopology) {
+	fmt.Println(titleStyle.Render("CPU TOPOLOGY"))
+
+	rows := [][]string{
+		{"Packages:", strconv.Itoa(topology.Packages)},
+		{"Dies:", strconv.Itoa(topology.Dies)},
+		{"Physical Cores:", strconv.Itoa(topology.Cores)},
+		{"Threads:", strconv.Itoa(topology.Threads)},
+	}
+
+	if topology.Hybrid {
+		counts := make(map[string]int)
+		for _, t := range topology.CPUs {
+			counts[t.CoreType]++
+		}
+		rows = append(rows, []string{"Core Types:", fmt.Sprintf("%d performance, %d efficiency threads",
+			counts[gops.CoreTypePerformance], counts[gops.CoreTypeEfficiency])})
+	}
+
+	for _, node := range topology.NUMANodes {
+		rows = append(rows, []string{fmt.Sprintf("NUMA Node %d:", node.Node), formatCPUList(node.CPUs)})
+	}
+
+	printTable(rows)
+
+	if len(topology.Caches) > 0 {
+		fmt.Println()
+		fmt.Println(keyStyle.Render("Caches:"))
+
+		var cacheRows [][]string
+		for _, cache := range topology.Caches {
+			cacheRows = append(cacheRows, []string{
+				fmt.Sprintf("L%d %s:", cache.Level, cache.Type),
+				fmt.Sprintf("%s shared by %s", formatBytes(cache.Size), formatCPUList(cache.SharedCPUs)),
+			})
+		}
+		printTable(cacheRows)
+	}
+
+	fmt.Println()
+	fmt.Println(keyStyle.Render("Cores:"))
+
+	seen := make(map[string]bool)
+	var coreRows [][]string
+	for _, t := range topology.CPUs {
+		key := fmt.Sprintf("%d/%d/%d", t.Package, t.Die, t.Core)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		value := fmt.Sprintf("cpus %s, node %d", formatCPUList(t.Siblings), t.NUMANode)
+		if t.CoreType != "" {
+			value += ", " + t.CoreType
+		}
+		coreRows = append(coreRows, []string{
+			fmt.Sprintf("Pkg %d Die %d Core %d:", t.Package, t.Die, t.Core),
+			value,
+		})
+	}
+	printTable(coreRows)
+}
+
+// formatCPUList renders CPU ids in the kernel cpulist format, e.g. "0-3,8".
+func formatCPUList(cpus []int) string {
+	var parts []string
+	for i := 0; i < len(cpus); {
+		j := i
+		for j+1 < len(cpus) && cpus[j+1] == cpus[j]+1 {
+			j++
+		}
+		if j > i {
+			parts = append(parts, fmt.Sprintf("%d-%d", cpus[i], cpus[j]))
+		} else {
+			parts = append(parts, strconv.Itoa(cpus[i]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
 func displayGPUInfo(gpuInfo *models.GPUInfo) {
 	fmt.Println(titleStyle.Render("GPU"))
 
@@ -580,6 +1587,31 @@ func displayMetaInfo(meta *models.MetaInfo) {
 		fmt.Println()
 	}
 
+	if meta.Zram != nil {
+		displayZramInfo(meta.Zram)
+		fmt.Println()
+	}
+
+	if meta.NUMA != nil {
+		displayNUMAInfo(meta.NUMA)
+		fmt.Println()
+	}
+
+	if meta.VMStat != nil {
+		displayVMStat(meta.VMStat)
+		fmt.Println()
+	}
+
+	if len(meta.Sensors) > 0 {
+		displaySensors(meta.Sensors)
+		fmt.Println()
+	}
+
+	if meta.Topology != nil {
+		displayCPUTopology(meta.Topology)
+		fmt.Println()
+	}
+
 	if meta.GPU != nil {
 		displayGPUInfo(meta.GPU)
 		fmt.Println()
@@ -600,11 +1632,46 @@ func displayMetaInfo(meta *models.MetaInfo) {
 		fmt.Println()
 	}
 
+	if len(meta.BlockDevices) > 0 {
+		displayBlockDevices(meta.BlockDevices)
+		fmt.Println()
+	}
+
+	if len(meta.Smart) > 0 {
+		displaySmart(meta.Smart)
+		fmt.Println()
+	}
+
+	if len(meta.StoragePools) > 0 {
+		displayStoragePools(meta.StoragePools)
+		fmt.Println()
+	}
+
+	if meta.Connections != nil {
+		displayConnections(meta.Connections)
+		fmt.Println()
+	}
+
+	if meta.NetStack != nil {
+		displayNetStack(meta.NetStack)
+		fmt.Println()
+	}
+
 	if meta.DiskRate != nil {
 		displayDiskRates(meta.DiskRate)
 		fmt.Println()
 	}
 
+	if meta.Pressure != nil {
+		displayPressure(meta.Pressure)
+		fmt.Println()
+	}
+
+	if meta.Interrupts != nil {
+		displayInterrupts(meta.Interrupts, 10)
+		fmt.Println()
+	}
+
 	if len(meta.Processes) > 0 {
 		displayProcesses(meta.Processes)
 	}
@@ -652,9 +1719,17 @@ func displayNetworkRates(netRates *models.NetworkRateResponse) {
 		rows := [][]string{
 			{"RX Rate:", formatRate(iface.RxRate)},
 			{"TX Rate:", formatRate(iface.TxRate)},
+			{"RX Packets:", fmt.Sprintf("%.1f/s", iface.RxPacketRate)},
+			{"TX Packets:", fmt.Sprintf("%.1f/s", iface.TxPacketRate)},
 			{"RX Total:", formatBytes(iface.RxTotal)},
 			{"TX Total:", formatBytes(iface.TxTotal)},
 		}
+		if iface.RxErrorRate > 0 || iface.TxErrorRate > 0 {
+			rows = append(rows, []string{"Errors:", fmt.Sprintf("%.1f/s in, %.1f/s out", iface.RxErrorRate, iface.TxErrorRate)})
+		}
+		if iface.RxDropRate > 0 || iface.TxDropRate > 0 {
+			rows = append(rows, []string{"Drops:", fmt.Sprintf("%.1f/s in, %.1f/s out", iface.RxDropRate, iface.TxDropRate)})
+		}
 
 		printTable(rows)
 	}
@@ -684,6 +1759,16 @@ func displayDiskRates(diskRates *models.DiskRateResponse) {
 			{"Write Total:", formatBytes(disk.WriteTotal)},
 			{"Read Count:", fmt.Sprintf("%d", disk.ReadCount)},
 			{"Write Count:", fmt.Sprintf("%d", disk.WriteCount)},
+			{"IOPS:", fmt.Sprintf("r %.1f/s  w %.1f/s  (merged r %.1f/s  w %.1f/s)", disk.ReadIOPS, disk.WriteIOPS, disk.ReadMerged, disk.WriteMerged)},
+			{"Await:", fmt.Sprintf("r %.2f ms  w %.2f ms", disk.ReadAwait, disk.WriteAwait)},
+			{"Request Size:", fmt.Sprintf("r %s  w %s", formatBytes(uint64(disk.ReadReqSize)), formatBytes(uint64(disk.WriteReqSize)))},
+			{"Queue / Util:", fmt.Sprintf("aqu-sz %.2f  util %.1f%%  in flight %d", disk.AvgQueueSize, disk.Util, disk.InFlight)},
+		}
+		if disk.DiscardIOPS > 0 {
+			rows = append(rows, []string{"Discard:", fmt.Sprintf("%.1f/s  %s  await %.2f ms", disk.DiscardIOPS, formatRate(disk.DiscardRate), disk.DiscardAwait)})
+		}
+		if disk.FlushIOPS > 0 {
+			rows = append(rows, []string{"Flush:", fmt.Sprintf("%.1f/s  await %.2f ms", disk.FlushIOPS, disk.FlushAwait)})
 		}
 
 		printTable(rows)
@@ -692,6 +1777,100 @@ func displayDiskRates(diskRates *models.DiskRateResponse) {
 	fmt.Printf("\nCursor: %s\n", diskRates.Cursor)
 }
 
+func displayPressure(pressure *models.PressureResponse) {
+	fmt.Println(titleStyle.Render("PRESSURE STALL INFO"))
+
+	if len(pressure.Resources) == 0 {
+		fmt.Println(valueStyle.Render("  No pressure information found"))
+		return
+	}
+
+	for i, res := range pressure.Resources {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		fmt.Println(keyStyle.Render(fmt.Sprintf("Resource: %s", res.Resource)))
+
+		var rows [][]string
+		if res.Some != nil {
+			rows = append(rows, []string{"Some:", formatPressureStat(res.Some)})
+		}
+		if res.Full != nil {
+			rows = append(rows, []string{"Full:", formatPressureStat(res.Full)})
+		}
+
+		printTable(rows)
+	}
+
+	fmt.Printf("\nCursor: %s\n", pressure.Cursor)
+}
+
+func formatPressureStat(stat *models.PressureStat) string {
+	return fmt.Sprintf("avg10 %.2f%%  avg60 %.2f%%  avg300 %.2f%%  stalled %.2f%%",
+		stat.Avg10, stat.Avg60, stat.Avg300, stat.StallPercent)
+}
+
+func displayInterrupts(interrupts *models.InterruptsResponse, limit int) {
+	fmt.Println(titleStyle.Render("INTERRUPTS"))
+	displayInterruptTable(interrupts.Interrupts, interrupts.CPUs, limit)
+
+	if len(interrupts.Softirqs) > 0 {
+		fmt.Println()
+		fmt.Println(titleStyle.Render("SOFTIRQS"))
+		displayInterruptTable(interrupts.Softirqs, interrupts.CPUs, limit)
+	}
+
+	fmt.Printf("\nCursor: %s\n", interrupts.Cursor)
+}
+
+func displayInterruptTable(infos []*models.InterruptInfo, cpus []int, limit int) {
+	if len(infos) == 0 {
+		fmt.Println(valueStyle.Render("  No interrupts found"))
+		return
+	}
+
+	if limit > 0 && len(infos) > limit {
+		infos = infos[:limit]
+	}
+
+	var rows [][]string
+	for _, info := range infos {
+		name := info.IRQ
+		if info.Description != "" {
+			name = fmt.Sprintf("%s (%s)", info.IRQ, truncateString(info.Description, 40))
+		}
+
+		// Use rates when the cursor provided them, raw counters otherwise
+		values := make([]float64, len(info.PerCPU))
+		total := float64(info.Total)
+		value := fmt.Sprintf("%d total", info.Total)
+		if info.PerCPURate != nil {
+			copy(values, info.PerCPURate)
+			total = info.Rate
+			value = fmt.Sprintf("%.1f/s", info.Rate)
+		} else {
+			for i, count := range info.PerCPU {
+				values[i] = float64(count)
+			}
+		}
+
+		busiest := -1
+		for i, v := range values {
+			if v > 0 && (busiest < 0 || v > values[busiest]) {
+				busiest = i
+			}
+		}
+		if busiest >= 0 && busiest < len(cpus) && total > 0 {
+			value += fmt.Sprintf(", CPU%d %.0f%%", cpus[busiest], values[busiest]/total*100)
+		}
+
+		rows = append(rows, []string{name + ":", value})
+	}
+
+	printTable(rows)
+}
+
 func formatRate(bytesPerSecond float64) string {
 	return fmt.Sprintf("%s/s", formatBytesFloat(bytesPerSecond))
 }
@@ -737,6 +1916,34 @@ func runDiskRateCommand(gopsUtil *gops.GopsUtil) error {
 	return nil
 }
 
+func runPressureCommand(gopsUtil *gops.GopsUtil) error {
+	pressureInfo, err := gopsUtil.GetPressure(pressureCursor)
+	if err != nil {
+		return fmt.Errorf("failed to get pressure info: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(pressureInfo)
+	}
+
+	displayPressure(pressureInfo)
+	return nil
+}
+
+func runInterruptsCommand(gopsUtil *gops.GopsUtil) error {
+	interruptsInfo, err := gopsUtil.GetInterrupts(interruptsCursor)
+	if err != nil {
+		return fmt.Errorf("failed to get interrupts: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(interruptsInfo)
+	}
+
+	displayInterrupts(interruptsInfo, interruptLimit)
+	return nil
+}
+
 func runTopCommand(gopsUtil *gops.GopsUtil) error {
 	return runTUIWithOptions(gopsUtil, hideCPUCores, summarizeCores)
 }
@@ -5,28 +5,50 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/AvengeMedia/dgop/config"
 	"github.com/AvengeMedia/dgop/gops"
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/spf13/cobra"
 )
 
 var (
-	Version        = "dev"
-	jsonOutput     bool
-	procSortBy     string
-	procLimit      int
-	disableProcCPU bool
-	mergeChildren  bool
-	metaModules    []string
-	gpuPciId       string
-	metaGPUPciIds  []string
-	cpuCursor      string
-	procCursor     string
-	netRateCursor  string
-	diskRateCursor string
-	hideCPUCores   bool
-	summarizeCores bool
+	Version          = "dev"
+	jsonOutput       bool
+	procSortBy       string
+	procLimit        int
+	disableProcCPU   bool
+	mergeChildren    bool
+	procNet          bool
+	metaModules      []string
+	gpuPciId         string
+	metaGPUPciIds    []string
+	cpuCursor        string
+	procCursor       string
+	netRateCursor    string
+	diskRateCursor   string
+	diskMountsCursor string
+	pressureCursor   string
+	interruptsCursor string
+	interruptLimit   int
+	systemCursor     string
+	numaCursor       string
+	vmstatCursor     string
+	netstackCursor   string
+	memoryVerbose    bool
+	hideCPUCores     bool
+	summarizeCores   bool
+	resourceScope    string
+	netPreset        string
+	netInclude       []string
+	netExclude       []string
+	netTypes         []string
+	networkFilter    models.NetworkFilter
+	connProtocol     string
+	connState        string
+	connPort         int
+	connPID          int32
 )
 
 var titleStyle = lipgloss.NewStyle().
@@ -61,8 +83,13 @@ func printHeader() {
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output in JSON format")
 	rootCmd.PersistentFlags().BoolVar(&disableProcCPU, "no-cpu", false, "Disable CPU calculation for faster process listing")
+	rootCmd.PersistentFlags().StringVar(&resourceScope, "scope", "auto", "Report memory, CPU and process figures relative to the cgroup or the host (auto, cgroup, host)")
+	rootCmd.PersistentFlags().StringVar(&netPreset, "net-preset", "", "Network interface preset (default, all, physical), overrides ~/.config/dgop/network.json")
+	rootCmd.PersistentFlags().StringSliceVar(&netInclude, "net-include", nil, "Only show network interfaces matching these globs (e.g. en*,wg*)")
+	rootCmd.PersistentFlags().StringSliceVar(&netExclude, "net-exclude", nil, "Hide network interfaces matching these globs, on top of the preset and ~/.config/dgop/network.json excludes")
+	rootCmd.PersistentFlags().StringSliceVar(&netTypes, "net-types", nil, "Only show network interfaces of these types (ethernet, wireless, wwan, bridge, bond, tun, tunnel, virtual, ...)")
 
-	allCmd.Flags().StringVar(&procSortBy, "sort", "cpu", "Sort processes by (cpu, memory, name, pid)")
+	allCmd.Flags().StringVar(&procSortBy, "sort", "cpu", "Sort processes by (cpu, memory, name, pid, io, net)")
 	allCmd.Flags().IntVar(&procLimit, "limit", 0, "Limit number of processes (0 = no limit)")
 	allCmd.Flags().StringVar(&cpuCursor, "cpu-cursor", "", "CPU cursor from previous request")
 	allCmd.Flags().StringVar(&procCursor, "proc-cursor", "", "Process cursor from previous request")
@@ -70,23 +97,53 @@ func init() {
 
 	cpuCmd.Flags().StringVar(&cpuCursor, "cursor", "", "Cursor from previous CPU request")
 
+	memoryCmd.Flags().BoolVar(&memoryVerbose, "verbose", false, "Show the full meminfo breakdown (slab, dirty, commit, hugepages, KSM)")
+
 	netRateCmd.Flags().StringVar(&netRateCursor, "cursor", "", "Cursor from previous network rate request")
 
+	diskCmd.Flags().StringVar(&diskMountsCursor, "cursor", "", "Cursor from previous disk request, enables fill-rate forecasts")
+
 	diskRateCmd.Flags().StringVar(&diskRateCursor, "cursor", "", "Cursor from previous disk rate request")
 
-	processesCmd.Flags().StringVar(&procSortBy, "sort", "cpu", "Sort processes by (cpu, memory, name, pid)")
+	pressureCmd.Flags().StringVar(&pressureCursor, "cursor", "", "Cursor from previous pressure request")
+
+	interruptsCmd.Flags().StringVar(&interruptsCursor, "cursor", "", "Cursor from previous interrupts request")
+	interruptsCmd.Flags().IntVar(&interruptLimit, "limit", 10, "Number of top interrupt sources to show (0 = all)")
+
+	systemCmd.Flags().StringVar(&systemCursor, "cursor", "", "Cursor from previous system request")
+
+	numaCmd.Flags().StringVar(&numaCursor, "cursor", "", "Cursor from previous NUMA request")
+
+	vmstatCmd.Flags().StringVar(&vmstatCursor, "cursor", "", "Cursor from previous vmstat request")
+
+	netstackCmd.Flags().StringVar(&netstackCursor, "cursor", "", "Cursor from previous netstack request")
+
+	connectionsCmd.Flags().StringVar(&connProtocol, "protocol", "", "Only show sockets of this protocol (tcp, tcp6, udp, udp6, unix)")
+	connectionsCmd.Flags().StringVar(&connState, "state", "", "Only show sockets in this state (e.g. LISTEN, ESTABLISHED, TIME_WAIT)")
+	connectionsCmd.Flags().IntVar(&connPort, "port", 0, "Only show sockets with this local or remote port")
+	connectionsCmd.Flags().Int32Var(&connPID, "pid", 0, "Only show sockets owned by this process")
+
+	processesCmd.Flags().StringVar(&procSortBy, "sort", "cpu", "Sort processes by (cpu, memory, name, pid, io, net)")
 	processesCmd.Flags().IntVar(&procLimit, "limit", 0, "Limit number of processes (0 = no limit)")
 	processesCmd.Flags().StringVar(&procCursor, "cursor", "", "Cursor from previous process request")
 	processesCmd.Flags().BoolVar(&mergeChildren, "merge-children", true, "Merge child processes with same executable")
+	processesCmd.Flags().BoolVar(&procNet, "net", false, "Attribute network traffic to processes (always on with --sort net)")
 
 	metaCmd.Flags().StringSliceVar(&metaModules, "modules", []string{"all"}, "Modules to include (cpu,memory,network,etc)")
-	metaCmd.Flags().StringVar(&procSortBy, "sort", "cpu", "Sort processes by (cpu, memory, name, pid)")
+	metaCmd.Flags().StringVar(&procSortBy, "sort", "cpu", "Sort processes by (cpu, memory, name, pid, io, net)")
 	metaCmd.Flags().IntVar(&procLimit, "limit", 0, "Limit number of processes (0 = no limit)")
 	metaCmd.Flags().StringSliceVar(&metaGPUPciIds, "gpu-pci-ids", []string{}, "PCI IDs for GPU temperatures (e.g., 10de:2684,1002:164e)")
 	metaCmd.Flags().StringVar(&cpuCursor, "cpu-cursor", "", "CPU cursor from previous request")
 	metaCmd.Flags().StringVar(&procCursor, "proc-cursor", "", "Process cursor from previous request")
 	metaCmd.Flags().StringVar(&netRateCursor, "net-rate-cursor", "", "Network rate cursor from previous request")
 	metaCmd.Flags().StringVar(&diskRateCursor, "disk-rate-cursor", "", "Disk rate cursor from previous request")
+	metaCmd.Flags().StringVar(&diskMountsCursor, "mounts-cursor", "", "Disk mounts cursor from previous request")
+	metaCmd.Flags().StringVar(&pressureCursor, "pressure-cursor", "", "Pressure cursor from previous request")
+	metaCmd.Flags().StringVar(&interruptsCursor, "interrupts-cursor", "", "Interrupts cursor from previous request")
+	metaCmd.Flags().StringVar(&systemCursor, "system-cursor", "", "System activity cursor from previous request")
+	metaCmd.Flags().StringVar(&numaCursor, "numa-cursor", "", "NUMA cursor from previous request")
+	metaCmd.Flags().StringVar(&vmstatCursor, "vmstat-cursor", "", "vmstat cursor from previous request")
+	metaCmd.Flags().StringVar(&netstackCursor, "netstack-cursor", "", "TCP/IP stack cursor from previous request")
 	metaCmd.Flags().BoolVar(&mergeChildren, "merge-children", true, "Merge child processes with same executable")
 
 	gpuTempCmd.Flags().StringVar(&gpuPciId, "pci-id", "", "PCI ID of GPU to get temperature (e.g., 10de:2684)")
@@ -98,19 +155,31 @@ func init() {
 
 var rootCmd = &cobra.Command{
 	Use: "dankgop",
-	Run: func(cmd *cobra.Command, args []string) {
-		gopsUtil := gops.NewGopsUtil()
-		if err := runTUIWithOptions(gopsUtil, hideCPUCores, summarizeCores); err != nil {
-			log.Fatal(err)
-		}
-	},
 }
 
 func main() {
 	gopsUtil := gops.NewGopsUtil()
 
-	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		cmd.SetContext(cmd.Context())
+
+		scope, err := gops.ParseScope(resourceScope)
+		if err != nil {
+			return err
+		}
+		gopsUtil.SetScope(scope)
+
+		fileFilter, err := config.LoadNetworkFilter()
+		if err != nil {
+			return err
+		}
+		networkFilter = gops.OverlayNetworkFilter(fileFilter, models.NetworkFilter{
+			Preset:  netPreset,
+			Include: netInclude,
+			Exclude: netExclude,
+			Types:   netTypes,
+		})
+		return gopsUtil.SetNetworkFilter(networkFilter)
 	}
 
 	setupCommands(gopsUtil)
@@ -127,21 +196,39 @@ func setupCommands(gopsUtil *gops.GopsUtil) {
 	rootCmd.AddCommand(allCmd)
 	rootCmd.AddCommand(cpuCmd)
 	rootCmd.AddCommand(memoryCmd)
+	rootCmd.AddCommand(zramCmd)
+	rootCmd.AddCommand(numaCmd)
+	rootCmd.AddCommand(vmstatCmd)
 	rootCmd.AddCommand(networkCmd)
 	rootCmd.AddCommand(diskCmd)
+	rootCmd.AddCommand(blockDevicesCmd)
+	rootCmd.AddCommand(smartCmd)
+	rootCmd.AddCommand(storagePoolsCmd)
+	rootCmd.AddCommand(connectionsCmd)
+	rootCmd.AddCommand(netstackCmd)
 	rootCmd.AddCommand(processesCmd)
 	rootCmd.AddCommand(systemCmd)
 	rootCmd.AddCommand(hardwareCmd)
+	rootCmd.AddCommand(topologyCmd)
+	rootCmd.AddCommand(sensorsCmd)
 	rootCmd.AddCommand(gpuCmd)
 	rootCmd.AddCommand(gpuTempCmd)
 	rootCmd.AddCommand(metaCmd)
 	rootCmd.AddCommand(modulesCmd)
 	rootCmd.AddCommand(netRateCmd)
 	rootCmd.AddCommand(diskRateCmd)
+	rootCmd.AddCommand(pressureCmd)
+	rootCmd.AddCommand(interruptsCmd)
 	rootCmd.AddCommand(topCmd)
 	rootCmd.AddCommand(serverCmd)
 
 	// Set gopsUtil for all commands
+	rootCmd.Run = func(cmd *cobra.Command, args []string) {
+		if err := runTUIWithOptions(gopsUtil, hideCPUCores, summarizeCores); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	allCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		return runAllCommand(gopsUtil)
 	}
@@ -154,6 +241,18 @@ func setupCommands(gopsUtil *gops.GopsUtil) {
 		return runMemoryCommand(gopsUtil)
 	}
 
+	zramCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runZramCommand(gopsUtil)
+	}
+
+	numaCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runNUMACommand(gopsUtil)
+	}
+
+	vmstatCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runVMStatCommand(gopsUtil)
+	}
+
 	networkCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		return runNetworkCommand(gopsUtil)
 	}
@@ -166,10 +265,38 @@ func setupCommands(gopsUtil *gops.GopsUtil) {
 		return runDiskRateCommand(gopsUtil)
 	}
 
+	pressureCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runPressureCommand(gopsUtil)
+	}
+
+	interruptsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runInterruptsCommand(gopsUtil)
+	}
+
 	diskCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		return runDiskCommand(gopsUtil)
 	}
 
+	blockDevicesCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runBlockDevicesCommand(gopsUtil)
+	}
+
+	smartCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runSmartCommand(gopsUtil)
+	}
+
+	storagePoolsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runStoragePoolsCommand(gopsUtil)
+	}
+
+	connectionsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runConnectionsCommand(gopsUtil)
+	}
+
+	netstackCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runNetStackCommand(gopsUtil)
+	}
+
 	processesCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		return runProcessesCommand(gopsUtil)
 	}
@@ -182,6 +309,14 @@ func setupCommands(gopsUtil *gops.GopsUtil) {
 		return runHardwareCommand(gopsUtil)
 	}
 
+	sensorsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runSensorsCommand(gopsUtil)
+	}
+
+	topologyCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runTopologyCommand(gopsUtil)
+	}
+
 	gpuCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		return runGPUCommand(gopsUtil)
 	}
@@ -231,6 +366,10 @@ func parseProcessSortBy(sortBy string, cpuDisabled bool) gops.ProcSortBy {
 		return gops.SortByName
 	case "pid":
 		return gops.SortByPID
+	case "io":
+		return gops.SortByIO
+	case "net":
+		return gops.SortByNet
 	default:
 		// Default behavior: CPU if enabled, memory if CPU disabled
 		if cpuDisabled {
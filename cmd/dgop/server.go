@@ -115,10 +115,15 @@ func startAPI(cfg *config.Config) error {
 		cancel() // This will propagate cancellation to all derived contexts
 	}()
 
+	gopsUtil := gops.NewGopsUtil()
+	if err := gopsUtil.SetNetworkFilter(networkFilter); err != nil {
+		return err
+	}
+
 	// Implementation
 	srvImpl := &server.Server{
 		Cfg:  cfg,
-		Gops: gops.NewGopsUtil(),
+		Gops: gopsUtil,
 	}
 
 	// New chi router
@@ -84,6 +84,9 @@ func NewResponsiveTUIModelWithOptions(gopsUtil *gops.GopsUtil, hideCPUCores, sum
 	model.hardware = hardware
 	model.distroLogo, model.distroColor = getDistroInfo(hardware)
 
+	topology, _ := gopsUtil.GetCPUTopology()
+	model.topology = topology
+
 	// Color change monitoring will be handled in the update loop
 
 	return model
@@ -153,6 +156,44 @@ func (m *ResponsiveTUIModel) renderProgressBar(used, total uint64, width int, co
 	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(bar.String())
 }
 
+// renderCPUTimeBar draws a stacked bar of user/system/iowait/irq/steal time so
+// iowait stalls and hypervisor steal stand out from ordinary load.
+func (m *ResponsiveTUIModel) renderCPUTimeBar(b *models.CPUTimeBreakdown, width int) string {
+	colors := m.getColors()
+	segments := []struct {
+		percent float64
+		color   string
+	}{
+		{b.User + b.Nice, colors.Charts.CPUCoreLow},
+		{b.System, colors.Charts.CPUCoreHigh},
+		{b.Iowait, colors.Status.Warning},
+		{b.Irq + b.Softirq, colors.Status.Info},
+		{b.Steal, colors.Status.Error},
+	}
+
+	var bar strings.Builder
+	bar.Grow(width * 4)
+
+	used := 0
+	for _, seg := range segments {
+		cells := int(math.Round(float64(width) * seg.percent / 100.0))
+		if cells == 0 && seg.percent >= 1.0 {
+			cells = 1
+		}
+		if used+cells > width {
+			cells = width - used
+		}
+		if cells <= 0 {
+			continue
+		}
+		bar.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color(seg.color)).Render(strings.Repeat("▓", cells)))
+		used += cells
+	}
+	bar.WriteString(strings.Repeat(" ", width-used))
+
+	return bar.String()
+}
+
 func (m *ResponsiveTUIModel) renderSystemInfoPanel(width, height int) string {
 	style := m.panelStyle(width, height)
 
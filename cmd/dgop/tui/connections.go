@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// Order of TCP states in the panel title, busiest kinds first
+var connectionStateOrder = []string{"ESTABLISHED", "LISTEN", "TIME_WAIT", "CLOSE_WAIT", "SYN_SENT", "SYN_RECV"}
+
+// inetConnections drops unix sockets, the view mirrors ss -tupan
+func inetConnections(conns *models.ConnectionsResponse) []*models.Connection {
+	if conns == nil {
+		return nil
+	}
+	inet := make([]*models.Connection, 0, len(conns.Connections))
+	for _, conn := range conns.Connections {
+		if conn.Protocol != "unix" {
+			inet = append(inet, conn)
+		}
+	}
+	return inet
+}
+
+func formatConnectionSummary(summary *models.ConnectionSummary) string {
+	if summary == nil || len(summary.TCPStates) == 0 {
+		return ""
+	}
+
+	seen := make(map[string]bool, len(connectionStateOrder))
+	var parts []string
+	for _, state := range connectionStateOrder {
+		seen[state] = true
+		if count := summary.TCPStates[state]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", shortTCPState(state), count))
+		}
+	}
+
+	var rest []string
+	for state := range summary.TCPStates {
+		if !seen[state] {
+			rest = append(rest, state)
+		}
+	}
+	sort.Strings(rest)
+	for _, state := range rest {
+		parts = append(parts, fmt.Sprintf("%s %d", shortTCPState(state), summary.TCPStates[state]))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func shortTCPState(state string) string {
+	switch state {
+	case "ESTABLISHED":
+		return "ESTAB"
+	case "TIME_WAIT":
+		return "TIME-W"
+	case "CLOSE_WAIT":
+		return "CLOSE-W"
+	}
+	return state
+}
+
+func formatConnectionEndpoint(addr string, port int) string {
+	if port == 0 {
+		return net.JoinHostPort(addr, "*")
+	}
+	return net.JoinHostPort(addr, strconv.Itoa(port))
+}
+
+func (m *ResponsiveTUIModel) formatConnectionRow(conn *models.Connection, addrWidth int) string {
+	process := ""
+	if conn.PID != 0 {
+		process = fmt.Sprintf("%s/%d", conn.Command, conn.PID)
+	}
+
+	return fmt.Sprintf("%-5s %-7s %-*s %-*s %s",
+		conn.Protocol,
+		m.truncate(shortTCPState(conn.State), 7),
+		addrWidth, m.truncate(formatConnectionEndpoint(conn.LocalAddr, conn.LocalPort), addrWidth),
+		addrWidth, m.truncate(formatConnectionEndpoint(conn.RemoteAddr, conn.RemotePort), addrWidth),
+		process)
+}
+
+func (m *ResponsiveTUIModel) renderConnectionsPanel(width, height int) string {
+	style := m.panelStyle(width, height)
+	innerHeight := height - 2
+	innerWidth := width - 4
+
+	conns := inetConnections(m.connections)
+
+	title := fmt.Sprintf("CONNECTIONS (%d)", len(conns))
+	if m.connections != nil {
+		if summary := formatConnectionSummary(m.connections.Summary); summary != "" {
+			title += "  " + summary
+		}
+	}
+
+	lines := []string{m.titleStyle().Render(m.truncate(title, innerWidth))}
+
+	if m.connections == nil {
+		lines = append(lines, "Loading...")
+	} else {
+		// proto + state + process take about 30 columns, the rest is split between the two addresses
+		addrWidth := (innerWidth - 30) / 2
+		if addrWidth < 15 {
+			addrWidth = 15
+		}
+		if addrWidth > 47 {
+			addrWidth = 47
+		}
+
+		header := fmt.Sprintf("%-5s %-7s %-*s %-*s %s", "PROTO", "STATE", addrWidth, "LOCAL", addrWidth, "PEER", "PROCESS")
+		lines = append(lines, m.titleStyle().Render(m.truncate(header, innerWidth)))
+
+		rows := innerHeight - len(lines)
+		m.clampConnectionsOffset(len(conns), rows)
+		for i := m.connectionsOffset; i < len(conns) && i < m.connectionsOffset+rows; i++ {
+			lines = append(lines, m.truncate(m.formatConnectionRow(conns[i], addrWidth), innerWidth))
+		}
+	}
+
+	for len(lines) < innerHeight {
+		lines = append(lines, "")
+	}
+	if len(lines) > innerHeight {
+		lines = lines[:innerHeight]
+	}
+
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+func (m *ResponsiveTUIModel) clampConnectionsOffset(total, rows int) {
+	maxOffset := total - rows
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if m.connectionsOffset > maxOffset {
+		m.connectionsOffset = maxOffset
+	}
+	if m.connectionsOffset < 0 {
+		m.connectionsOffset = 0
+	}
+}
@@ -11,10 +11,16 @@ import (
 )
 
 type fetchDataMsg struct {
-	metrics    *models.SystemMetrics
-	err        error
-	generation int
-	cpuCursor  string
+	metrics      *models.SystemMetrics
+	err          error
+	generation   int
+	cpuCursor    string
+	systemCursor string
+	numaCursor   string
+	vmstatCursor string
+	zram         *models.ZramInfo
+	numa         *models.NUMAResponse
+	vmstat       *models.VMStatResponse
 }
 
 type fetchProcessesMsg struct {
@@ -39,6 +45,21 @@ type fetchTempMsg struct {
 	err   error
 }
 
+type fetchStoragePoolsMsg struct {
+	pools []*models.StoragePool
+	err   error
+}
+
+type fetchConnectionsMsg struct {
+	connections *models.ConnectionsResponse
+	err         error
+}
+
+type fetchNetworkInfoMsg struct {
+	interfaces []*models.NetworkInfo
+	err        error
+}
+
 type processKillResultMsg struct {
 	message string
 }
@@ -62,17 +83,23 @@ func killProcess(pid int32, force bool) tea.Cmd {
 func (m *ResponsiveTUIModel) fetchData() tea.Cmd {
 	generation := m.fetchGeneration
 	cpuCursor := m.cpuCursor
+	systemCursor := m.systemCursor
+	numaCursor := m.numaCursor
+	vmstatCursor := m.vmstatCursor
 	sortBy := m.sortBy
 	procLimit := m.procLimit
 	return func() tea.Msg {
 		params := gops.MetaParams{
-			SortBy:    sortBy,
-			ProcLimit: procLimit,
-			EnableCPU: true,
-			CPUCursor: cpuCursor,
+			SortBy:       sortBy,
+			ProcLimit:    procLimit,
+			EnableCPU:    true,
+			CPUCursor:    cpuCursor,
+			SystemCursor: systemCursor,
+			NUMACursor:   numaCursor,
+			VMStatCursor: vmstatCursor,
 		}
 
-		modules := []string{"cpu", "memory", "system"}
+		modules := []string{"cpu", "memory", "system", "zram", "numa", "vmstat"}
 		metrics, err := m.gops.GetMeta(context.Background(), modules, params)
 
 		if err != nil {
@@ -94,11 +121,32 @@ func (m *ResponsiveTUIModel) fetchData() tea.Cmd {
 			newCPUCursor = metrics.CPU.Cursor
 		}
 
+		newSystemCursor := ""
+		if metrics.System != nil {
+			newSystemCursor = metrics.System.Cursor
+		}
+
+		newNUMACursor := ""
+		if metrics.NUMA != nil {
+			newNUMACursor = metrics.NUMA.Cursor
+		}
+
+		newVMStatCursor := ""
+		if metrics.VMStat != nil {
+			newVMStatCursor = metrics.VMStat.Cursor
+		}
+
 		return fetchDataMsg{
-			metrics:    systemMetrics,
-			err:        nil,
-			generation: generation,
-			cpuCursor:  newCPUCursor,
+			metrics:      systemMetrics,
+			err:          nil,
+			generation:   generation,
+			cpuCursor:    newCPUCursor,
+			systemCursor: newSystemCursor,
+			numaCursor:   newNUMACursor,
+			vmstatCursor: newVMStatCursor,
+			zram:         metrics.Zram,
+			numa:         metrics.NUMA,
+			vmstat:       metrics.VMStat,
 		}
 	}
 }
@@ -145,3 +193,24 @@ func (m *ResponsiveTUIModel) fetchTemperatureData() tea.Cmd {
 		return fetchTempMsg{temps: temps, err: err}
 	}
 }
+
+func (m *ResponsiveTUIModel) fetchStoragePoolData() tea.Cmd {
+	return func() tea.Msg {
+		pools, err := m.gops.GetStoragePools()
+		return fetchStoragePoolsMsg{pools: pools, err: err}
+	}
+}
+
+func (m *ResponsiveTUIModel) fetchConnectionsData() tea.Cmd {
+	return func() tea.Msg {
+		connections, err := m.gops.GetConnections(gops.ConnectionFilter{})
+		return fetchConnectionsMsg{connections: connections, err: err}
+	}
+}
+
+func (m *ResponsiveTUIModel) fetchNetworkInfoData() tea.Cmd {
+	return func() tea.Msg {
+		interfaces, err := m.gops.GetNetworkInfo()
+		return fetchNetworkInfoMsg{interfaces: interfaces, err: err}
+	}
+}
@@ -2,6 +2,8 @@ package tui
 
 import (
 	"fmt"
+	"github.com/AvengeMedia/dgop/gops"
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/charmbracelet/lipgloss"
 	"strconv"
 	"strings"
@@ -38,6 +40,34 @@ func (m *ResponsiveTUIModel) renderMemDiskPanel(width, height int) string {
 			content = append(content, fmt.Sprintf("%s %.1f%%", swapBar, swapPercent))
 			content = append(content, fmt.Sprintf("%.1f/%.1fGB Swap", swapUsedGB, swapTotalGB))
 		}
+
+		if m.vmstat != nil {
+			content = append(content, m.formatPagingActivity(m.vmstat))
+		}
+
+		// Compressed swap: original data -> RAM actually used by the pool
+		if m.zram != nil {
+			for _, dev := range m.zram.Devices {
+				content = append(content, fmt.Sprintf("%s %s→%s %.1fx %s",
+					dev.Device, m.formatBytes(dev.OrigData), m.formatBytes(dev.MemUsed), dev.CompressionRatio, dev.Algorithm))
+			}
+			if z := m.zram.Zswap; z != nil && z.Enabled {
+				content = append(content, fmt.Sprintf("zswap %s→%s %.1fx %s",
+					m.formatBytes(z.OrigData), m.formatBytes(z.PoolSize), z.CompressionRatio, z.Compressor))
+			}
+		}
+
+		// Per-node usage only tells something when there are nodes to compare
+		if m.numa != nil && len(m.numa.Nodes) > 1 {
+			for _, node := range m.numa.Nodes {
+				nodeBar := m.renderProgressBar(node.Used, node.Total, barWidth-4, "memory")
+				line := fmt.Sprintf("N%d %s %.1f%%", node.Node, nodeBar, node.UsedPercent)
+				if node.MissRate > 0 {
+					line += fmt.Sprintf(" miss %s/s", formatCompactRate(node.MissRate))
+				}
+				content = append(content, line)
+			}
+		}
 	} else {
 		content = append(content, "Loading memory info...")
 	}
@@ -98,6 +128,10 @@ func (m *ResponsiveTUIModel) renderMemDiskPanel(width, height int) string {
 			disksShown++
 		}
 
+		for _, pool := range m.storagePools {
+			content = append(content, m.formatStoragePool(pool))
+		}
+
 		// Add disk I/O chart
 		if len(m.diskHistory) > 1 {
 			content = append(content, "")
@@ -147,3 +181,48 @@ func (m *ResponsiveTUIModel) renderMemDiskPanel(width, height int) string {
 
 	return style.Render(strings.Join(lines, "\n"))
 }
+
+// formatPagingActivity summarises fault and swap rates, turning the warning
+// colour on while swapping and the error colour once direct reclaim or the
+// OOM killer kick in.
+func (m *ResponsiveTUIModel) formatPagingActivity(vm *models.VMStatResponse) string {
+	r := vm.Rates
+	line := fmt.Sprintf("flt %s/s maj %s/s si/so %s/%s",
+		formatCompactRate(r.PgFault), formatCompactRate(r.PgMajFault),
+		formatCompactRate(r.PswpIn), formatCompactRate(r.PswpOut))
+
+	colors := m.getColors()
+	switch {
+	case vm.OomKills > 0:
+		line += fmt.Sprintf(" OOM×%d", vm.OomKills)
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Status.Error)).Bold(true).Render(line)
+	case r.PgScanDirect > 0 || r.AllocStall > 0:
+		line += " direct reclaim"
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Status.Error)).Render(line)
+	case r.PswpIn > 0 || r.PswpOut > 0:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Status.Warning)).Render(line)
+	}
+	return line
+}
+
+// formatStoragePool shows one line per array or pool, in the error colour
+// whenever it is not healthy so a dropped member stands out.
+func (m *ResponsiveTUIModel) formatStoragePool(pool *models.StoragePool) string {
+	line := pool.Name
+	if pool.Level != "" {
+		line += " " + pool.Level
+	}
+	line += " " + pool.Status
+	if pool.Devices > 0 && pool.Working < pool.Devices {
+		line += fmt.Sprintf(" %d/%d", pool.Working, pool.Devices)
+	}
+	if pool.Operation != "" {
+		line += fmt.Sprintf(" %s %.1f%%", pool.Operation, pool.Progress)
+	}
+
+	if pool.Status != gops.PoolHealthy {
+		colors := m.getColors()
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Status.Error)).Bold(true).Render(line)
+	}
+	return line
+}
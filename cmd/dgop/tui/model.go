@@ -16,6 +16,8 @@ type NetworkSample struct {
 	txBytes   uint64
 	rxRate    float64
 	txRate    float64
+	errorRate float64
+	dropRate  float64
 }
 
 type DiskSample struct {
@@ -50,6 +52,10 @@ type ResponsiveTUIModel struct {
 	processTable table.Model
 
 	hardware   *models.SystemHardware
+	topology   *models.CPUTopology
+	zram       *models.ZramInfo
+	numa       *models.NUMAResponse
+	vmstat     *models.VMStatResponse
 	diskMounts []*models.DiskMountInfo
 
 	networkHistory        []NetworkSample
@@ -63,12 +69,26 @@ type ResponsiveTUIModel struct {
 	diskCursor     string
 	lastDiskUpdate time.Time
 
-	cpuCursor  string
-	procCursor string
+	cpuCursor    string
+	procCursor   string
+	systemCursor string
+	numaCursor   string
+	vmstatCursor string
 
 	systemTemperatures []models.TemperatureSensor
 	lastTempUpdate     time.Time
 
+	storagePools    []*models.StoragePool
+	lastPoolsUpdate time.Time
+
+	networkInterfaces []*models.NetworkInfo
+	lastNetInfoUpdate time.Time
+
+	showConnections       bool
+	connections           *models.ConnectionsResponse
+	connectionsOffset     int
+	lastConnectionsUpdate time.Time
+
 	sortBy          gops.ProcSortBy
 	procLimit       int
 	ready           bool
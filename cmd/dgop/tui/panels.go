@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/AvengeMedia/dgop/gops"
 	"github.com/AvengeMedia/dgop/models"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func (m *ResponsiveTUIModel) renderCPUPanel(width, height int) string {
@@ -32,15 +34,27 @@ func (m *ResponsiveTUIModel) renderCPUPanel(width, height int) string {
 
 	// CPU name as title, with right-aligned frequency - align with core layout
 	freqText := fmt.Sprintf("%.0fMHz", cpu.Frequency)
+	if len(cpu.CoreFreqs) > 0 && cpu.CoreFreqs[0].Governor != "" {
+		freqText = cpu.CoreFreqs[0].Governor + " " + freqText
+	}
+	// Throttle indicator when the cursor interval saw thermal throttle events
+	throttleText := ""
+	if cpu.Throttled {
+		throttleText = "▲THROTTLE "
+	}
 	// Calculate spaces to align with core columns - adjust for proper C/MHz alignment
 	availableWidth := width - 5 // account for borders+padding, align with cores
-	spaces := availableWidth - len(cpuName) - len(freqText)
+	spaces := availableWidth - len(cpuName) - lipgloss.Width(throttleText) - len(freqText)
 	if spaces < 1 {
 		spaces = 1
 	}
 
 	titleLine := m.titleStyle().Render(cpuName)
-	content.WriteString(titleLine + strings.Repeat(" ", spaces) + freqText + "\n")
+	if throttleText != "" {
+		warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.getColors().Status.Error)).Bold(true)
+		throttleText = warnStyle.Render(throttleText)
+	}
+	content.WriteString(titleLine + strings.Repeat(" ", spaces) + throttleText + freqText + "\n")
 
 	// CPU bar with usage and temperature - make bar wider so temp isn't too far left
 	barWidth := width - 15 // Make bar wider to push temp right
@@ -49,6 +63,9 @@ func (m *ResponsiveTUIModel) renderCPUPanel(width, height int) string {
 	}
 
 	cpuBar := m.renderProgressBar(uint64(cpu.Usage*100), 10000, barWidth, "cpu")
+	if cpu.Breakdown != nil {
+		cpuBar = m.renderCPUTimeBar(cpu.Breakdown, barWidth)
+	}
 	// Format as fixed-width strings for consistent alignment
 	usageText := fmt.Sprintf("%3.0f%%", cpu.Usage) // Always 3 chars for percentage (e.g. " 5%" or "100%")
 	tempText := fmt.Sprintf("%.0f°C", cpu.Temperature)
@@ -95,39 +112,38 @@ func (m *ResponsiveTUIModel) renderDetailedCores(content *strings.Builder, cpu *
 
 	// Each core needs space for "C00" (3 chars) + bar + "100%" (4 chars) = 7 + bar (no spaces)
 	coreBarWidth := columnWidth - 8 // More space for wider bars
+
+	// Per-core frequency (" 3.2G") only when every core reports one and the bar stays readable
+	showFreq := len(cpu.CoreFreqs) == len(cpu.CoreUsage) && coreBarWidth-5 >= 6
+	if showFreq {
+		coreBarWidth -= 5
+	}
 	if coreBarWidth < 6 {
 		coreBarWidth = 6
 	}
 
 	for i := 0; i < len(cpu.CoreUsage); i += 3 {
-		var line strings.Builder
-
-		// First core - format as "C01[bar]5%" with no spaces, add separator
-		core1 := cpu.CoreUsage[i]
-		core1Bar := m.renderProgressBar(uint64(core1*100), 10000, coreBarWidth, "cpu")
-		core1Str := fmt.Sprintf("C%02d%s%3.0f%%", i, core1Bar, core1) // No spaces
-		line.WriteString(core1Str)
-		line.WriteString(" ") // Space separator between columns
-
-		// Second core if exists
-		if i+1 < len(cpu.CoreUsage) {
-			core2 := cpu.CoreUsage[i+1]
-			core2Bar := m.renderProgressBar(uint64(core2*100), 10000, coreBarWidth, "cpu")
-			core2Str := fmt.Sprintf("C%02d%s%3.0f%%", i+1, core2Bar, core2)
-			line.WriteString(core2Str)
-			line.WriteString(" ") // Space separator between columns
-		}
-
-		// Third core if exists
-		if i+2 < len(cpu.CoreUsage) {
-			core3 := cpu.CoreUsage[i+2]
-			core3Bar := m.renderProgressBar(uint64(core3*100), 10000, coreBarWidth, "cpu")
-			core3Str := fmt.Sprintf("C%02d%s%3.0f%%", i+2, core3Bar, core3)
-			line.WriteString(core3Str) // No separator after last column
+		var cells []string
+		for j := i; j < i+3 && j < len(cpu.CoreUsage); j++ {
+			cells = append(cells, m.renderCoreCell(cpu, j, coreBarWidth, showFreq))
 		}
+		content.WriteString(strings.Join(cells, " ") + "\n")
+	}
+}
 
-		content.WriteString(line.String() + "\n")
+// renderCoreCell formats a single core as "C01[bar]  5%" with no inner spaces,
+// optionally followed by its current frequency in GHz.
+func (m *ResponsiveTUIModel) renderCoreCell(cpu *models.CPUInfo, idx, barWidth int, showFreq bool) string {
+	usage := cpu.CoreUsage[idx]
+	bar := m.renderProgressBar(uint64(usage*100), 10000, barWidth, "cpu")
+	if idx < len(cpu.CoreBreakdown) {
+		bar = m.renderCPUTimeBar(&cpu.CoreBreakdown[idx], barWidth)
 	}
+	cell := fmt.Sprintf("C%02d%s%3.0f%%", idx, bar, usage)
+	if showFreq {
+		cell += fmt.Sprintf("%4.1fG", cpu.CoreFreqs[idx].Current/1000.0)
+	}
+	return cell
 }
 
 func (m *ResponsiveTUIModel) renderSummarizedCores(content *strings.Builder, cpu *models.CPUInfo, width int) {
@@ -146,19 +162,14 @@ func (m *ResponsiveTUIModel) renderSummarizedCores(content *strings.Builder, cpu
 		barWidth = 10
 	}
 
-	for i := 0; i < totalCores; i += groupSize {
-		endIdx := i + groupSize
-		if endIdx > totalCores {
-			endIdx = totalCores
-		}
-
+	for _, group := range summarizeCoreGroups(m.topology, totalCores, groupSize) {
 		// Calculate average usage for this group
 		var avgUsage float64
 		var maxUsage float64
 		activeCount := 0
 
-		for j := i; j < endIdx; j++ {
-			usage := cpu.CoreUsage[j]
+		for _, idx := range group.cpus {
+			usage := cpu.CoreUsage[idx]
 			avgUsage += usage
 			if usage > maxUsage {
 				maxUsage = usage
@@ -167,13 +178,12 @@ func (m *ResponsiveTUIModel) renderSummarizedCores(content *strings.Builder, cpu
 				activeCount++
 			}
 		}
-		avgUsage /= float64(endIdx - i)
+		avgUsage /= float64(len(group.cpus))
 
 		// Display group summary
-		groupName := fmt.Sprintf("C%02d-%02d", i, endIdx-1)
 		avgBar := m.renderProgressBar(uint64(avgUsage*100), 10000, barWidth, "cpu")
 		groupInfo := fmt.Sprintf("%s %s %3.0f%% avg (max:%3.0f%% active:%d)\n",
-			groupName, avgBar, avgUsage, maxUsage, activeCount)
+			group.label, avgBar, avgUsage, maxUsage, activeCount)
 		content.WriteString(groupInfo)
 	}
 
@@ -188,7 +198,87 @@ func (m *ResponsiveTUIModel) renderSummarizedCores(content *strings.Builder, cpu
 	}
 	totalAvg /= float64(totalCores)
 
-	summary := fmt.Sprintf("Total: %d cores, %d active (>1%%), %.1f%% average\n",
-		totalCores, totalActive, totalAvg)
+	coresText := fmt.Sprintf("%d cores", totalCores)
+	if m.topology != nil && m.topology.Threads == totalCores && m.topology.Cores != totalCores {
+		coresText = fmt.Sprintf("%d cores/%d threads", m.topology.Cores, totalCores)
+	}
+	summary := fmt.Sprintf("Total: %s, %d active (>1%%), %.1f%% average\n",
+		coresText, totalActive, totalAvg)
 	content.WriteString(summary)
 }
+
+type coreGroup struct {
+	label string
+	cpus  []int
+}
+
+// summarizeCoreGroups splits the logical CPUs into summary groups. With a
+// topology, SMT siblings stay together and groups are split by core type on
+// hybrid CPUs (or by package on multi-socket systems); otherwise consecutive
+// CPU indices are grouped.
+func summarizeCoreGroups(topology *models.CPUTopology, totalCores, groupSize int) []coreGroup {
+	if topology == nil || len(topology.CPUs) != totalCores {
+		var groups []coreGroup
+		for i := 0; i < totalCores; i += groupSize {
+			endIdx := min(i+groupSize, totalCores)
+			group := coreGroup{label: fmt.Sprintf("C%02d-%02d", i, endIdx-1)}
+			for j := i; j < endIdx; j++ {
+				group.cpus = append(group.cpus, j)
+			}
+			groups = append(groups, group)
+		}
+		return groups
+	}
+
+	type physicalCore struct {
+		partition string
+		cpus      []int
+	}
+
+	var partitions []string
+	byPartition := make(map[string][]*physicalCore)
+	coreIndex := make(map[[3]int]*physicalCore)
+
+	for _, t := range topology.CPUs {
+		if t.CPU < 0 || t.CPU >= totalCores {
+			return summarizeCoreGroups(nil, totalCores, groupSize)
+		}
+
+		key := [3]int{t.Package, t.Die, t.Core}
+		if core, ok := coreIndex[key]; ok {
+			core.cpus = append(core.cpus, t.CPU)
+			continue
+		}
+
+		partition := "C"
+		switch {
+		case topology.Hybrid && t.CoreType == gops.CoreTypePerformance:
+			partition = "P"
+		case topology.Hybrid && t.CoreType == gops.CoreTypeEfficiency:
+			partition = "E"
+		case topology.Packages > 1:
+			partition = fmt.Sprintf("S%d C", t.Package)
+		}
+
+		core := &physicalCore{partition: partition, cpus: []int{t.CPU}}
+		coreIndex[key] = core
+		if _, ok := byPartition[partition]; !ok {
+			partitions = append(partitions, partition)
+		}
+		byPartition[partition] = append(byPartition[partition], core)
+	}
+
+	var groups []coreGroup
+	for _, partition := range partitions {
+		cores := byPartition[partition]
+		for i := 0; i < len(cores); i += groupSize {
+			endIdx := min(i+groupSize, len(cores))
+			group := coreGroup{label: fmt.Sprintf("%s%02d-%02d", partition, i, endIdx-1)}
+			for _, core := range cores[i:endIdx] {
+				group.cpus = append(group.cpus, core.cpus...)
+			}
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
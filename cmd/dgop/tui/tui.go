@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -18,7 +19,7 @@ func (m *ResponsiveTUIModel) Init() tea.Cmd {
 	diskMounts, _ := m.gops.GetDiskMounts()
 	m.diskMounts = diskMounts
 
-	cmds := []tea.Cmd{tick(), m.fetchData(), m.fetchProcessData(), m.fetchTemperatureData()}
+	cmds := []tea.Cmd{tick(), m.fetchData(), m.fetchProcessData(), m.fetchTemperatureData(), m.fetchStoragePoolData(), m.fetchNetworkInfoData()}
 
 	if m.colorManager != nil {
 		cmds = append(cmds, m.listenForColorChanges())
@@ -79,8 +80,14 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(m.fetchData(), m.fetchProcessData())
 		case "d":
 			m.showDetails = !m.showDetails
+		case "s":
+			m.showConnections = !m.showConnections
+			if m.showConnections {
+				m.lastConnectionsUpdate = time.Now()
+				return m, m.fetchConnectionsData()
+			}
 		case "x":
-			if m.metrics != nil && len(m.metrics.Processes) > 0 {
+			if !m.showConnections && m.metrics != nil && len(m.metrics.Processes) > 0 {
 				idx := m.processTable.Cursor()
 				if idx < len(m.metrics.Processes) {
 					m.killConfirmPID = m.metrics.Processes[idx].PID
@@ -129,6 +136,10 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.fetchGeneration++
 			return m, m.fetchProcessData()
 		case "up", "k":
+			if m.showConnections {
+				m.connectionsOffset--
+				return m, nil
+			}
 			oldCursor := m.processTable.Cursor()
 			m.processTable, cmd = m.processTable.Update(msg)
 			cmds = append(cmds, cmd)
@@ -138,6 +149,10 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectedPID = m.metrics.Processes[newCursor].PID
 			}
 		case "down", "j":
+			if m.showConnections {
+				m.connectionsOffset++
+				return m, nil
+			}
 			oldCursor := m.processTable.Cursor()
 			m.processTable, cmd = m.processTable.Update(msg)
 			cmds = append(cmds, cmd)
@@ -177,6 +192,21 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.lastTempUpdate = now
 		}
 
+		if now.Sub(m.lastPoolsUpdate) >= 10*time.Second {
+			cmds = append(cmds, m.fetchStoragePoolData())
+			m.lastPoolsUpdate = now
+		}
+
+		if m.showConnections && now.Sub(m.lastConnectionsUpdate) >= 2*time.Second {
+			cmds = append(cmds, m.fetchConnectionsData())
+			m.lastConnectionsUpdate = now
+		}
+
+		if now.Sub(m.lastNetInfoUpdate) >= 10*time.Second {
+			cmds = append(cmds, m.fetchNetworkInfoData())
+			m.lastNetInfoUpdate = now
+		}
+
 		if m.logoTestMode && now.Sub(m.lastLogoUpdate) >= 3*time.Second {
 			allLogos := getAllDistroLogos()
 			m.currentLogoIndex = (m.currentLogoIndex + 1) % len(allLogos)
@@ -205,6 +235,12 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.err = msg.err
 		m.cpuCursor = msg.cpuCursor
+		m.systemCursor = msg.systemCursor
+		m.zram = msg.zram
+		m.numa = msg.numa
+		m.numaCursor = msg.numaCursor
+		m.vmstat = msg.vmstat
+		m.vmstatCursor = msg.vmstatCursor
 		m.lastUpdate = time.Now()
 
 	case fetchProcessesMsg:
@@ -235,6 +271,8 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					txBytes:   bestInterface.TxTotal,
 					rxRate:    bestInterface.RxRate,
 					txRate:    bestInterface.TxRate,
+					errorRate: bestInterface.RxErrorRate + bestInterface.TxErrorRate,
+					dropRate:  bestInterface.RxDropRate + bestInterface.TxDropRate,
 				}
 
 				m.networkHistory = append(m.networkHistory, sample)
@@ -294,6 +332,21 @@ func (m *ResponsiveTUIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.systemTemperatures = msg.temps
 		}
 
+	case fetchStoragePoolsMsg:
+		if msg.err == nil {
+			m.storagePools = msg.pools
+		}
+
+	case fetchConnectionsMsg:
+		if msg.err == nil {
+			m.connections = msg.connections
+		}
+
+	case fetchNetworkInfoMsg:
+		if msg.err == nil {
+			m.networkInterfaces = msg.interfaces
+		}
+
 	case processKillResultMsg:
 		m.killResultMsg = msg.message
 		m.killResultTime = time.Now()
@@ -465,10 +518,13 @@ func (m *ResponsiveTUIModel) renderMainContentWithHeight(availableHeight int) st
 		rightWidth = 10
 	}
 
+	// The connections view replaces processes, so there is no process to show details for
+	showDetails := m.showDetails && !m.showConnections
+
 	// Chrome calculation (full borders only - gaps are rendered but not budgeted)
 	leftPanels := 3
 	rightPanels := 2
-	if m.showDetails {
+	if showDetails {
 		rightPanels = 3
 	}
 
@@ -513,7 +569,7 @@ func (m *ResponsiveTUIModel) renderMainContentWithHeight(availableHeight int) st
 	detMax := 24
 
 	var rightHeights []int
-	if m.showDetails {
+	if showDetails {
 		rightSpecs := []panelSpec{
 			{cpuMin, cpuMax, 0},   // CPU: no flex
 			{procMin, procMax, 3}, // Processes: main flex
@@ -539,7 +595,9 @@ func (m *ResponsiveTUIModel) renderMainContentWithHeight(availableHeight int) st
 
 	cpuPanel := m.renderCPUPanel(rightWidth, rightHeights[0])
 	var processColumn string
-	if m.showDetails {
+	if m.showConnections {
+		processColumn = m.renderConnectionsPanel(rightWidth, rightHeights[1])
+	} else if showDetails {
 		processPanel := m.renderProcessPanel(rightWidth, rightHeights[1])
 		detailsPanel := m.renderProcessDetailsPanel(rightWidth, rightHeights[2])
 
@@ -564,11 +622,17 @@ func (m *ResponsiveTUIModel) renderMainContentWithHeight(availableHeight int) st
 func (m *ResponsiveTUIModel) renderHeader() string {
 	style := m.headerStyle()
 
-	// Just show current time in header
+	// Show kernel activity rates next to the current time when they fit
 	currentTime := time.Now().Format("15:04:05")
 	rightText := currentTime
 
 	title := fmt.Sprintf("dgop %s", Version)
+	if m.metrics != nil && m.metrics.System != nil && m.metrics.System.Activity != nil {
+		activityText := formatKernelActivity(m.metrics.System.Activity) + "  " + currentTime
+		if m.width-len(title)-len(activityText)-4 >= 1 {
+			rightText = activityText
+		}
+	}
 	// rightText already set above
 	spaces := m.width - len(title) - len(rightText) - 4
 	if spaces < 0 {
@@ -579,6 +643,22 @@ func (m *ResponsiveTUIModel) renderHeader() string {
 	return style.Render(headerText)
 }
 
+func formatKernelActivity(a *models.KernelActivity) string {
+	return fmt.Sprintf("ctxsw %s/s  forks %s/s  run %d  blocked %d",
+		formatCompactRate(a.ContextSwitchRate), formatCompactRate(a.ForkRate), a.ProcsRunning, a.ProcsBlocked)
+}
+
+func formatCompactRate(rate float64) string {
+	switch {
+	case rate >= 1e6:
+		return fmt.Sprintf("%.1fM", rate/1e6)
+	case rate >= 1e3:
+		return fmt.Sprintf("%.1fk", rate/1e3)
+	default:
+		return fmt.Sprintf("%.0f", rate)
+	}
+}
+
 func (m *ResponsiveTUIModel) renderFooter() string {
 	style := m.footerStyle()
 	colors := m.getColors()
@@ -624,7 +704,7 @@ func (m *ResponsiveTUIModel) renderFooter() string {
 	if m.mergeChildren {
 		groupStatus = "*"
 	}
-	controls := fmt.Sprintf("Controls: [q]uit [r]efresh [d]etails [g]roup%s [x] kill | Sort: [c]pu [m]emory [n]ame [p]id | ↑↓ Navigate", groupStatus)
+	controls := fmt.Sprintf("Controls: [q]uit [r]efresh [d]etails [g]roup%s [x] kill [s]ockets | Sort: [c]pu [m]emory [n]ame [p]id | ↑↓ Navigate", groupStatus)
 	return style.Render(controls)
 }
 
@@ -774,7 +854,15 @@ func (m *ResponsiveTUIModel) renderNetworkPanel(width, height int) string {
 	rxRateStr := m.formatBytes(uint64(latest.rxRate))
 	txRateStr := m.formatBytes(uint64(latest.txRate))
 
-	content.WriteString(fmt.Sprintf("↓%s/s ↑%s/s\n", rxRateStr, txRateStr))
+	content.WriteString(fmt.Sprintf("↓%s/s ↑%s/s", rxRateStr, txRateStr))
+	if warning := m.formatNetworkHealth(latest); warning != "" {
+		content.WriteString(" " + warning)
+	}
+	content.WriteString("\n")
+
+	if details := m.formatInterfaceDetails(m.selectedNetworkInfo()); details != "" {
+		content.WriteString(m.truncate(details, width-4) + "\n")
+	}
 
 	// Build totals line first to know exact space needed
 	totalRx := m.formatBytes(latest.rxBytes)
@@ -1015,15 +1103,16 @@ func (m *ResponsiveTUIModel) selectBestNetworkInterface(interfaces []*models.Net
 		return nil
 	}
 
-	isIgnored := func(name string) bool {
-		return name == "lo" ||
-			strings.HasPrefix(name, "docker") ||
-			strings.HasPrefix(name, "br-") ||
-			strings.HasPrefix(name, "veth") ||
-			strings.HasPrefix(name, "bridge")
+	// Prefer physical links; the type is only known once network info has
+	// been fetched, and never on darwin, so fall back to the usual names
+	types := make(map[string]string, len(m.networkInterfaces))
+	for _, info := range m.networkInterfaces {
+		types[info.Name] = info.Type
 	}
-
 	isPrimary := func(name string) bool {
+		if t := types[name]; t != "" {
+			return t == "ethernet" || t == "wireless" || t == "wwan" || t == "infiniband"
+		}
 		return strings.HasPrefix(name, "en") ||
 			strings.HasPrefix(name, "eth") ||
 			strings.HasPrefix(name, "wlan") ||
@@ -1037,7 +1126,7 @@ func (m *ResponsiveTUIModel) selectBestNetworkInterface(interfaces []*models.Net
 
 	var candidates []*models.NetworkRateInfo
 	for _, iface := range interfaces {
-		if isIgnored(iface.Interface) {
+		if m.gops != nil && !m.gops.AllowsNetworkInterface(iface.Interface) {
 			continue
 		}
 		candidates = append(candidates, iface)
@@ -1103,6 +1192,57 @@ func (m *ResponsiveTUIModel) selectBestNetworkInterface(interfaces []*models.Net
 	return bestInterface
 }
 
+// formatNetworkHealth flags a link that is erroring or dropping packets,
+// errors in the error colour and drops alone as a warning
+func (m *ResponsiveTUIModel) formatNetworkHealth(sample NetworkSample) string {
+	colors := m.getColors()
+	switch {
+	case sample.errorRate > 0:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Status.Error)).
+			Render(fmt.Sprintf("⚠ %.0f err/s", math.Ceil(sample.errorRate)))
+	case sample.dropRate > 0:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Status.Warning)).
+			Render(fmt.Sprintf("⚠ %.0f drop/s", math.Ceil(sample.dropRate)))
+	}
+	return ""
+}
+
+func (m *ResponsiveTUIModel) selectedNetworkInfo() *models.NetworkInfo {
+	for _, iface := range m.networkInterfaces {
+		if iface.Name == m.selectedInterfaceName {
+			return iface
+		}
+	}
+	return nil
+}
+
+// formatInterfaceDetails renders the first address and link speed, or the
+// wireless signal when the driver does not report a speed
+func (m *ResponsiveTUIModel) formatInterfaceDetails(iface *models.NetworkInfo) string {
+	if iface == nil {
+		return ""
+	}
+
+	var parts []string
+	switch {
+	case len(iface.IPv4) > 0:
+		parts = append(parts, strings.SplitN(iface.IPv4[0], "/", 2)[0])
+	case len(iface.IPv6) > 0:
+		parts = append(parts, strings.SplitN(iface.IPv6[0], "/", 2)[0])
+	}
+
+	switch {
+	case iface.Speed >= 1000 && iface.Speed%1000 == 0:
+		parts = append(parts, fmt.Sprintf("%d Gb/s", iface.Speed/1000))
+	case iface.Speed > 0:
+		parts = append(parts, fmt.Sprintf("%d Mb/s", iface.Speed))
+	case iface.Wireless != nil:
+		parts = append(parts, fmt.Sprintf("%.0f dBm", iface.Wireless.Signal))
+	}
+
+	return strings.Join(parts, " · ")
+}
+
 func (m *ResponsiveTUIModel) getSelectedInterfaceName() string {
 	if m.selectedInterfaceName != "" {
 		return strings.ToUpper(m.selectedInterfaceName)
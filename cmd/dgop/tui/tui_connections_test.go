@@ -0,0 +1,40 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatConnectionSummary(t *testing.T) {
+	summary := &models.ConnectionSummary{
+		TCPStates: map[string]int{"LISTEN": 4, "ESTABLISHED": 12, "FIN_WAIT2": 1, "CLOSING": 2},
+	}
+
+	require.Equal(t, "ESTAB 12 LISTEN 4 CLOSING 2 FIN_WAIT2 1", formatConnectionSummary(summary))
+	require.Empty(t, formatConnectionSummary(&models.ConnectionSummary{}))
+	require.Empty(t, formatConnectionSummary(nil))
+}
+
+func TestRenderConnectionsPanelScrolls(t *testing.T) {
+	conns := &models.ConnectionsResponse{Summary: &models.ConnectionSummary{}}
+	for port := 1; port <= 20; port++ {
+		conns.Connections = append(conns.Connections, &models.Connection{
+			Protocol: "tcp", LocalAddr: "127.0.0.1", LocalPort: port, RemoteAddr: "0.0.0.0", State: "LISTEN",
+		})
+	}
+	conns.Connections = append(conns.Connections, &models.Connection{Protocol: "unix", State: "LISTEN", Path: "/run/x.sock"})
+
+	m := &ResponsiveTUIModel{connections: conns, connectionsOffset: 100}
+	panel := m.renderConnectionsPanel(80, 10)
+
+	require.Equal(t, 10, lipgloss.Height(panel))
+	require.Contains(t, panel, "CONNECTIONS (20)")
+	require.Contains(t, panel, "127.0.0.1:20")
+	require.NotContains(t, panel, "/run/x.sock")
+	// 8 inner lines minus title and header leave 6 rows, so the offset is clamped to 14
+	require.Equal(t, 14, m.connectionsOffset)
+	require.NotContains(t, panel, "127.0.0.1:14 ")
+}
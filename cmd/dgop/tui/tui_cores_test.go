@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/gops"
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeCoreGroupsWithoutTopology(t *testing.T) {
+	groups := summarizeCoreGroups(nil, 10, 8)
+	require.Len(t, groups, 2)
+	require.Equal(t, "C00-07", groups[0].label)
+	require.Equal(t, []int{8, 9}, groups[1].cpus)
+}
+
+func TestSummarizeCoreGroupsKeepsSMTSiblingsTogether(t *testing.T) {
+	// 4 physical cores with siblings numbered N and N+4
+	topology := &models.CPUTopology{Packages: 1, Cores: 4, Threads: 8}
+	for cpu := 0; cpu < 8; cpu++ {
+		topology.CPUs = append(topology.CPUs, models.CPUThread{CPU: cpu, Core: cpu % 4})
+	}
+
+	groups := summarizeCoreGroups(topology, 8, 2)
+	require.Len(t, groups, 2)
+	require.Equal(t, "C00-01", groups[0].label)
+	require.Equal(t, []int{0, 4, 1, 5}, groups[0].cpus)
+	require.Equal(t, []int{2, 6, 3, 7}, groups[1].cpus)
+}
+
+func TestSummarizeCoreGroupsByCoreType(t *testing.T) {
+	topology := &models.CPUTopology{Packages: 1, Hybrid: true}
+	topology.CPUs = []models.CPUThread{
+		{CPU: 0, Core: 0, CoreType: gops.CoreTypePerformance},
+		{CPU: 1, Core: 0, CoreType: gops.CoreTypePerformance},
+		{CPU: 2, Core: 8, CoreType: gops.CoreTypeEfficiency},
+		{CPU: 3, Core: 9, CoreType: gops.CoreTypeEfficiency},
+	}
+
+	groups := summarizeCoreGroups(topology, 4, 8)
+	require.Len(t, groups, 2)
+	require.Equal(t, "P00-00", groups[0].label)
+	require.Equal(t, []int{0, 1}, groups[0].cpus)
+	require.Equal(t, "E00-01", groups[1].label)
+	require.Equal(t, []int{2, 3}, groups[1].cpus)
+}
+
+func TestSummarizeCoreGroupsFallsBackOnMismatch(t *testing.T) {
+	topology := &models.CPUTopology{CPUs: []models.CPUThread{{CPU: 0}}}
+	groups := summarizeCoreGroups(topology, 4, 8)
+	require.Len(t, groups, 1)
+	require.Equal(t, []int{0, 1, 2, 3}, groups[0].cpus)
+}
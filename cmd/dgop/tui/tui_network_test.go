@@ -3,6 +3,7 @@ package tui
 import (
 	"testing"
 
+	"github.com/AvengeMedia/dgop/gops"
 	"github.com/AvengeMedia/dgop/models"
 	"github.com/stretchr/testify/require"
 )
@@ -30,3 +31,66 @@ func TestSelectBestNetworkInterfaceSupportsUtun(t *testing.T) {
 	require.NotNil(t, best)
 	require.Equal(t, "utun4", best.Interface)
 }
+
+func TestSelectBestNetworkInterfacePrefersPhysicalType(t *testing.T) {
+	m := &ResponsiveTUIModel{
+		networkInterfaces: []*models.NetworkInfo{
+			{Name: "lan", Type: "ethernet"},
+			{Name: "wg0", Type: "tunnel"},
+			{Name: "eth1", Type: "virtual"},
+		},
+	}
+	interfaces := []*models.NetworkRateInfo{
+		{Interface: "wg0", RxRate: 9000, TxRate: 9000},
+		{Interface: "eth1", RxRate: 5000, TxRate: 5000},
+		{Interface: "lan", RxRate: 100, TxRate: 100},
+	}
+
+	best := m.selectBestNetworkInterface(interfaces)
+	require.NotNil(t, best)
+	require.Equal(t, "lan", best.Interface)
+}
+
+func TestSelectBestNetworkInterfaceAppliesFilter(t *testing.T) {
+	g := gops.NewGopsUtil()
+	require.NoError(t, g.SetNetworkFilter(models.NetworkFilter{Include: []string{"wg*"}}))
+
+	m := &ResponsiveTUIModel{gops: g}
+	interfaces := []*models.NetworkRateInfo{
+		{Interface: "enp3s0", RxRate: 9000, TxRate: 9000},
+		{Interface: "wg0", RxRate: 10, TxRate: 10},
+	}
+
+	best := m.selectBestNetworkInterface(interfaces)
+	require.NotNil(t, best)
+	require.Equal(t, "wg0", best.Interface)
+}
+
+func TestFormatInterfaceDetails(t *testing.T) {
+	m := &ResponsiveTUIModel{
+		selectedInterfaceName: "enp3s0",
+		networkInterfaces: []*models.NetworkInfo{
+			{Name: "wlp2s0", IPv4: []string{"10.0.0.5/24"}, Wireless: &models.WirelessInfo{Signal: -61}},
+			{Name: "enp3s0", IPv4: []string{"192.168.1.20/24"}, Speed: 2500},
+		},
+	}
+
+	require.Equal(t, "192.168.1.20 · 2500 Mb/s", m.formatInterfaceDetails(m.selectedNetworkInfo()))
+
+	m.selectedInterfaceName = "wlp2s0"
+	require.Equal(t, "10.0.0.5 · -61 dBm", m.formatInterfaceDetails(m.selectedNetworkInfo()))
+
+	m.networkInterfaces[1].Speed = 10000
+	require.Equal(t, "192.168.1.20 · 10 Gb/s", m.formatInterfaceDetails(m.networkInterfaces[1]))
+
+	m.selectedInterfaceName = "eth9"
+	require.Empty(t, m.formatInterfaceDetails(m.selectedNetworkInfo()))
+}
+
+func TestFormatNetworkHealth(t *testing.T) {
+	m := &ResponsiveTUIModel{}
+
+	require.Empty(t, m.formatNetworkHealth(NetworkSample{rxRate: 1000}))
+	require.Contains(t, m.formatNetworkHealth(NetworkSample{dropRate: 0.4}), "⚠ 1 drop/s")
+	require.Contains(t, m.formatNetworkHealth(NetworkSample{errorRate: 3, dropRate: 12}), "⚠ 3 err/s")
+}
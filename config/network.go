@@ -0,0 +1,38 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// LoadNetworkFilter reads the interface filter from ~/.config/dgop/network.json.
+// A missing file or home directory is not an error and leaves the default
+// preset in place.
+func LoadNetworkFilter() (models.NetworkFilter, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return models.NetworkFilter{}, nil
+	}
+	return loadNetworkFilterFrom(filepath.Join(configDir, "network.json"))
+}
+
+func loadNetworkFilterFrom(filePath string) (models.NetworkFilter, error) {
+	var filter models.NetworkFilter
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return filter, nil
+	}
+	if err != nil {
+		return filter, fmt.Errorf("failed to read network config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &filter); err != nil {
+		return filter, fmt.Errorf("failed to parse %s: %w", filePath, err)
+	}
+	return filter, nil
+}
@@ -0,0 +1,195 @@
+package gops
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// GetBlockDevices returns the block device stack as a tree rooted at the
+// physical disks, like lsblk: partitions and devices built on top of a disk
+// (dm-crypt, LVM, md) are its children. A device built from several disks
+// appears under each of them.
+func (self *GopsUtil) GetBlockDevices() ([]*models.BlockDevice, error) {
+	devices, err := self.readBlockDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	if partitions, err := self.diskProvider.Partitions(true); err == nil {
+		for _, p := range partitions {
+			if dev := self.findBlockDevice(devices, p.Device); dev != nil {
+				dev.Mountpoints = append(dev.Mountpoints, p.Mountpoint)
+			}
+		}
+	}
+
+	return buildBlockDeviceTree(devices), nil
+}
+
+func buildBlockDeviceTree(devices map[string]*models.BlockDevice) []*models.BlockDevice {
+	var roots []*models.BlockDevice
+	for _, dev := range devices {
+		var children []string
+		for _, other := range devices {
+			if other.Parent == dev.Name {
+				children = append(children, other.Name)
+			}
+		}
+		for _, holder := range dev.Holders {
+			if _, ok := devices[holder]; ok {
+				children = append(children, holder)
+			}
+		}
+
+		dev.Children = nil
+		for _, name := range uniqueSorted(children) {
+			dev.Children = append(dev.Children, devices[name])
+		}
+
+		// Unused loop and zram devices report a size of zero, skip them like lsblk
+		if dev.Parent == "" && len(dev.Slaves) == 0 && (dev.Size > 0 || len(dev.Children) > 0) {
+			roots = append(roots, dev)
+		}
+	}
+
+	sort.Slice(roots, func(i, j int) bool { return roots[i].Name < roots[j].Name })
+	return roots
+}
+
+// findBlockDevice maps a mount source such as /dev/sda1, /dev/dm-0,
+// /dev/mapper/vg-root or /dev/disk/by-uuid/... to its block device.
+func (self *GopsUtil) findBlockDevice(devices map[string]*models.BlockDevice, source string) *models.BlockDevice {
+	if !strings.HasPrefix(source, "/dev/") {
+		return nil
+	}
+	source = self.resolveLink(source)
+
+	name := filepath.Base(source)
+	if dev, ok := devices[name]; ok {
+		return dev
+	}
+	for _, dev := range devices {
+		if dev.DMName != "" && dev.DMName == name {
+			return dev
+		}
+	}
+	return nil
+}
+
+// resolveLink follows a symlink such as /sys/class/block/sda or
+// /dev/mapper/vg-root to its target, or returns path unchanged when it is
+// not a link
+func (self *GopsUtil) resolveLink(path string) string {
+	target, err := self.fs.Readlink(path)
+	if err != nil {
+		return path
+	}
+	if filepath.IsAbs(target) {
+		return filepath.Clean(target)
+	}
+	return filepath.Join(filepath.Dir(path), target)
+}
+
+// physicalDisks follows partitions and slaves down to the whole disks a
+// device is ultimately stored on.
+func physicalDisks(devices map[string]*models.BlockDevice, dev *models.BlockDevice) []string {
+	var disks []string
+	seen := make(map[string]bool)
+
+	var walk func(d *models.BlockDevice)
+	walk = func(d *models.BlockDevice) {
+		if d == nil || seen[d.Name] {
+			return
+		}
+		seen[d.Name] = true
+
+		switch {
+		case d.Parent != "":
+			walk(devices[d.Parent])
+		case len(d.Slaves) > 0:
+			for _, slave := range d.Slaves {
+				walk(devices[slave])
+			}
+		default:
+			disks = append(disks, d.Name)
+		}
+	}
+	walk(dev)
+
+	return uniqueSorted(disks)
+}
+
+// blockDeviceType classifies device-mapper devices by the uuid prefix their
+// target sets (CRYPT-, LVM-, mpath-), and everything else by name.
+func blockDeviceType(name, dmUUID string, isPartition bool) string {
+	switch {
+	case isPartition:
+		return "part"
+	case strings.HasPrefix(name, "dm-"):
+		switch {
+		case strings.HasPrefix(dmUUID, "CRYPT-"):
+			return "crypt"
+		case strings.HasPrefix(dmUUID, "LVM-"):
+			return "lvm"
+		case strings.HasPrefix(dmUUID, "mpath-"):
+			return "mpath"
+		}
+		return "dm"
+	case strings.HasPrefix(name, "md"):
+		return "raid"
+	case strings.HasPrefix(name, "loop"):
+		return "loop"
+	case strings.HasPrefix(name, "zram"):
+		return "zram"
+	}
+	return "disk"
+}
+
+// blockTransport guesses the bus from the device name and its resolved sysfs
+// path, e.g. /sys/devices/pci0000:00/.../usb2/2-1/.../block/sdb.
+func blockTransport(name, sysPath string) string {
+	switch {
+	case strings.HasPrefix(name, "nvme"):
+		return "nvme"
+	case strings.HasPrefix(name, "mmcblk"):
+		return "mmc"
+	case strings.Contains(sysPath, "/usb"):
+		return "usb"
+	case strings.Contains(sysPath, "/virtio"):
+		return "virtio"
+	case strings.Contains(sysPath, "/ata"):
+		return "sata"
+	case strings.Contains(sysPath, "/host") && strings.Contains(sysPath, "/target"):
+		return "scsi"
+	}
+	return ""
+}
+
+// parseScheduler returns the active entry of queue/scheduler, e.g.
+// "none [mq-deadline] kyber" -> "mq-deadline".
+func parseScheduler(content string) string {
+	content = strings.TrimSpace(content)
+	start := strings.Index(content, "[")
+	end := strings.Index(content, "]")
+	if start >= 0 && end > start {
+		return content[start+1 : end]
+	}
+	return content
+}
+
+func uniqueSorted(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Strings(values)
+	out := values[:1]
+	for _, v := range values[1:] {
+		if v != out[len(out)-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readBlockDevices() (map[string]*models.BlockDevice, error) {
+	return nil, fmt.Errorf("block device inventory is not supported on darwin")
+}
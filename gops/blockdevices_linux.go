@@ -0,0 +1,110 @@
+//go:build linux
+
+package gops
+
+import (
+	"path/filepath"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const classBlockSysfsPath = "/sys/class/block"
+
+func (self *GopsUtil) readBlockDevices() (map[string]*models.BlockDevice, error) {
+	return self.readBlockDevicesFrom(classBlockSysfsPath)
+}
+
+// readBlockDevicesFrom reads every entry of /sys/class/block, which holds
+// both whole disks and partitions. Queue and identity attributes only exist
+// on whole disks; partitions inherit them from their parent.
+func (self *GopsUtil) readBlockDevicesFrom(classRoot string) (map[string]*models.BlockDevice, error) {
+	entries, err := self.fs.ReadDir(classRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(map[string]*models.BlockDevice, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		dir := filepath.Join(classRoot, name)
+		sysPath := self.resolveLink(dir)
+
+		dev := &models.BlockDevice{
+			Name:    name,
+			Path:    "/dev/" + name,
+			MajMin:  self.readSysfsString(filepath.Join(dir, "dev")),
+			Holders: self.listSysfsDir(filepath.Join(dir, "holders")),
+			Slaves:  self.listSysfsDir(filepath.Join(dir, "slaves")),
+		}
+		if sectors, err := self.readSysfsUint(filepath.Join(dir, "size")); err == nil {
+			dev.Size = sectors * diskSectorSize
+		}
+		dev.ReadOnly = self.readSysfsString(filepath.Join(dir, "ro")) == "1"
+
+		partition := self.readSysfsInt(filepath.Join(dir, "partition"), 0)
+		if partition > 0 {
+			dev.Partition = partition
+			dev.Parent = filepath.Base(filepath.Dir(sysPath))
+		} else {
+			self.readWholeDiskAttributes(dev, dir, sysPath)
+		}
+
+		dev.DMName = self.readSysfsString(filepath.Join(dir, "dm", "name"))
+		dev.RaidLevel = self.readSysfsString(filepath.Join(dir, "md", "level"))
+		dev.Type = blockDeviceType(name, self.readSysfsString(filepath.Join(dir, "dm", "uuid")), partition > 0)
+
+		devices[name] = dev
+	}
+
+	// Partitions share their disk's media properties
+	for _, dev := range devices {
+		if parent, ok := devices[dev.Parent]; ok {
+			dev.Transport = parent.Transport
+			dev.Rotational = parent.Rotational
+			dev.Removable = parent.Removable
+			dev.LogicalSectorSize = parent.LogicalSectorSize
+			dev.PhysicalSectorSize = parent.PhysicalSectorSize
+		}
+	}
+
+	return devices, nil
+}
+
+func (self *GopsUtil) readWholeDiskAttributes(dev *models.BlockDevice, dir, sysPath string) {
+	dev.Model = self.readFirstSysfsString(filepath.Join(dir, "device", "model"))
+	dev.Vendor = self.readFirstSysfsString(filepath.Join(dir, "device", "vendor"))
+	dev.Serial = self.readFirstSysfsString(filepath.Join(dir, "device", "serial"), filepath.Join(dir, "serial"))
+	dev.WWN = self.readFirstSysfsString(filepath.Join(dir, "wwid"), filepath.Join(dir, "device", "wwid"))
+	dev.Transport = blockTransport(dev.Name, sysPath)
+	dev.Rotational = self.readSysfsString(filepath.Join(dir, "queue", "rotational")) == "1"
+	dev.Removable = self.readSysfsString(filepath.Join(dir, "removable")) == "1"
+	dev.Scheduler = parseScheduler(self.readSysfsString(filepath.Join(dir, "queue", "scheduler")))
+
+	if size, err := self.readSysfsUint(filepath.Join(dir, "queue", "logical_block_size")); err == nil {
+		dev.LogicalSectorSize = size
+	}
+	if size, err := self.readSysfsUint(filepath.Join(dir, "queue", "physical_block_size")); err == nil {
+		dev.PhysicalSectorSize = size
+	}
+}
+
+func (self *GopsUtil) readFirstSysfsString(paths ...string) string {
+	for _, path := range paths {
+		if value := self.readSysfsString(path); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (self *GopsUtil) listSysfsDir(dir string) []string {
+	entries, err := self.fs.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
@@ -0,0 +1,80 @@
+//go:build linux
+
+package gops
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBlockDevicesFrom(t *testing.T) {
+	disk := "/sys/devices/pci0000:00/0000:00:17.0/ata1/host0/target0:0:0/0:0:0:0/block/sda"
+	dm := "/sys/devices/virtual/block/dm-0"
+	gops, fsys := newFixtureGops(map[string]string{
+		disk + "/dev":                       "8:0",
+		disk + "/size":                      "2000",
+		disk + "/ro":                        "0",
+		disk + "/removable":                 "0",
+		disk + "/device/model":              "Samsung SSD 870",
+		disk + "/device/vendor":             "ATA",
+		disk + "/device/serial":             "S5XYZ",
+		disk + "/device/wwid":               "naa.5002538f00000000",
+		disk + "/queue/rotational":          "0",
+		disk + "/queue/scheduler":           "[none] mq-deadline",
+		disk + "/queue/logical_block_size":  "512",
+		disk + "/queue/physical_block_size": "4096",
+		disk + "/sda1/dev":                  "8:1",
+		disk + "/sda1/size":                 "1000",
+		disk + "/sda1/partition":            "1",
+		disk + "/sda1/holders/dm-0":         "",
+		dm + "/dev":                         "254:0",
+		dm + "/size":                        "990",
+		dm + "/dm/name":                     "cryptdata",
+		dm + "/dm/uuid":                     "CRYPT-LUKS2-0123-cryptdata",
+		dm + "/slaves/sda1":                 "",
+	})
+	fsys.symlink("/sys/class/block/sda", "../.."+strings.TrimPrefix(disk, "/sys"))
+	fsys.symlink("/sys/class/block/sda1", "../.."+strings.TrimPrefix(disk, "/sys")+"/sda1")
+	fsys.symlink("/sys/class/block/dm-0", "../../devices/virtual/block/dm-0")
+
+	devices, err := gops.readBlockDevices()
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+
+	sda := devices["sda"]
+	assert.Equal(t, "disk", sda.Type)
+	assert.Equal(t, "/dev/sda", sda.Path)
+	assert.Equal(t, "8:0", sda.MajMin)
+	assert.Equal(t, uint64(2000*512), sda.Size)
+	assert.Equal(t, "Samsung SSD 870", sda.Model)
+	assert.Equal(t, "ATA", sda.Vendor)
+	assert.Equal(t, "S5XYZ", sda.Serial)
+	assert.Equal(t, "naa.5002538f00000000", sda.WWN)
+	assert.Equal(t, "sata", sda.Transport)
+	assert.Equal(t, "none", sda.Scheduler)
+	assert.False(t, sda.Rotational)
+	assert.Equal(t, uint64(4096), sda.PhysicalSectorSize)
+
+	sda1 := devices["sda1"]
+	assert.Equal(t, "part", sda1.Type)
+	assert.Equal(t, 1, sda1.Partition)
+	assert.Equal(t, "sda", sda1.Parent)
+	assert.Equal(t, "sata", sda1.Transport)
+	assert.Equal(t, uint64(512), sda1.LogicalSectorSize)
+	assert.Equal(t, []string{"dm-0"}, sda1.Holders)
+
+	crypt := devices["dm-0"]
+	assert.Equal(t, "crypt", crypt.Type)
+	assert.Equal(t, "cryptdata", crypt.DMName)
+	assert.Equal(t, []string{"sda1"}, crypt.Slaves)
+	assert.Equal(t, []string{"sda"}, physicalDisks(devices, crypt))
+}
+
+func TestReadBlockDevicesFromMissing(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	_, err := gops.readBlockDevices()
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sda and sdb carry an md mirror holding a LUKS volume with LVM on top
+func stackedBlockDevices() map[string]*models.BlockDevice {
+	return map[string]*models.BlockDevice{
+		"sda":   {Name: "sda", Type: "disk", Size: 100, Holders: nil},
+		"sda1":  {Name: "sda1", Type: "part", Size: 90, Parent: "sda", Holders: []string{"md0"}},
+		"sdb":   {Name: "sdb", Type: "disk", Size: 100},
+		"sdb1":  {Name: "sdb1", Type: "part", Size: 90, Parent: "sdb", Holders: []string{"md0"}},
+		"md0":   {Name: "md0", Type: "raid", Size: 90, Slaves: []string{"sda1", "sdb1"}, Holders: []string{"dm-0"}},
+		"dm-0":  {Name: "dm-0", Type: "crypt", Size: 89, DMName: "cryptroot", Slaves: []string{"md0"}, Holders: []string{"dm-1"}},
+		"dm-1":  {Name: "dm-1", Type: "lvm", Size: 80, DMName: "vg-root", Slaves: []string{"dm-0"}},
+		"loop0": {Name: "loop0", Type: "loop"},
+	}
+}
+
+func TestBuildBlockDeviceTree(t *testing.T) {
+	roots := buildBlockDeviceTree(stackedBlockDevices())
+
+	require.Len(t, roots, 2)
+	assert.Equal(t, "sda", roots[0].Name)
+	assert.Equal(t, "sdb", roots[1].Name)
+
+	require.Len(t, roots[0].Children, 1)
+	part := roots[0].Children[0]
+	assert.Equal(t, "sda1", part.Name)
+	require.Len(t, part.Children, 1)
+	md := part.Children[0]
+	assert.Equal(t, "md0", md.Name)
+	assert.Same(t, md, roots[1].Children[0].Children[0])
+	assert.Equal(t, "dm-1", md.Children[0].Children[0].Name)
+}
+
+func TestPhysicalDisks(t *testing.T) {
+	devices := stackedBlockDevices()
+
+	assert.Equal(t, []string{"sda", "sdb"}, physicalDisks(devices, devices["dm-1"]))
+	assert.Equal(t, []string{"sdb"}, physicalDisks(devices, devices["sdb1"]))
+	assert.Nil(t, physicalDisks(devices, nil))
+}
+
+func TestFindBlockDevice(t *testing.T) {
+	devices := stackedBlockDevices()
+	gops, fsys := newFixtureGops(nil)
+	fsys.symlink("/dev/mapper/cryptroot", "../dm-0")
+	fsys.symlink("/dev/disk/by-uuid/0f3c9a1e-6b2d-4c1f-9e7a-2d5b8c4f1a03", "../../sda1")
+	fsys.symlink("/dev/root", "/dev/dm-1")
+
+	assert.Equal(t, "dm-1", gops.findBlockDevice(devices, "/dev/mapper/vg-root").Name, "falls back to the dm name")
+	assert.Equal(t, "dm-0", gops.findBlockDevice(devices, "/dev/mapper/cryptroot").Name)
+	assert.Equal(t, "sda1", gops.findBlockDevice(devices, "/dev/disk/by-uuid/0f3c9a1e-6b2d-4c1f-9e7a-2d5b8c4f1a03").Name)
+	assert.Equal(t, "dm-1", gops.findBlockDevice(devices, "/dev/root").Name)
+	assert.Equal(t, "sda1", gops.findBlockDevice(devices, "/dev/sda1").Name)
+	assert.Nil(t, gops.findBlockDevice(devices, "server:/export"))
+	assert.Nil(t, gops.findBlockDevice(devices, "/dev/nvme9n1"))
+}
+
+func TestBlockDeviceType(t *testing.T) {
+	assert.Equal(t, "part", blockDeviceType("sda1", "", true))
+	assert.Equal(t, "crypt", blockDeviceType("dm-0", "CRYPT-LUKS2-abc-cryptroot", false))
+	assert.Equal(t, "lvm", blockDeviceType("dm-1", "LVM-xyz", false))
+	assert.Equal(t, "mpath", blockDeviceType("dm-2", "mpath-3600", false))
+	assert.Equal(t, "dm", blockDeviceType("dm-3", "", false))
+	assert.Equal(t, "raid", blockDeviceType("md127", "", false))
+	assert.Equal(t, "loop", blockDeviceType("loop0", "", false))
+	assert.Equal(t, "zram", blockDeviceType("zram0", "", false))
+	assert.Equal(t, "disk", blockDeviceType("nvme0n1", "", false))
+}
+
+func TestBlockTransport(t *testing.T) {
+	assert.Equal(t, "nvme", blockTransport("nvme0n1", "/sys/devices/pci0000:00/0000:00:1d.0/0000:3d:00.0/nvme/nvme0/nvme0n1"))
+	assert.Equal(t, "usb", blockTransport("sdb", "/sys/devices/pci0000:00/0000:00:14.0/usb2/2-1/2-1:1.0/host4/target4:0:0/4:0:0:0/block/sdb"))
+	assert.Equal(t, "sata", blockTransport("sda", "/sys/devices/pci0000:00/0000:00:17.0/ata1/host0/target0:0:0/0:0:0:0/block/sda"))
+	assert.Equal(t, "virtio", blockTransport("vda", "/sys/devices/pci0000:00/0000:00:02.0/virtio1/block/vda"))
+	assert.Equal(t, "mmc", blockTransport("mmcblk0", "/sys/devices/platform/mmc0/block/mmcblk0"))
+	assert.Equal(t, "", blockTransport("dm-0", "/sys/devices/virtual/block/dm-0"))
+}
+
+func TestParseScheduler(t *testing.T) {
+	assert.Equal(t, "mq-deadline", parseScheduler("none [mq-deadline] kyber bfq\n"))
+	assert.Equal(t, "none", parseScheduler("none"))
+	assert.Equal(t, "", parseScheduler(""))
+}
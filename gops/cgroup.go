@@ -0,0 +1,223 @@
+package gops
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type Scope string
+
+const (
+	ScopeAuto   Scope = "auto"
+	ScopeCgroup Scope = "cgroup"
+	ScopeHost   Scope = "host"
+)
+
+func ParseScope(s string) (Scope, error) {
+	switch Scope(strings.ToLower(strings.TrimSpace(s))) {
+	case "", ScopeAuto:
+		return ScopeAuto, nil
+	case ScopeCgroup:
+		return ScopeCgroup, nil
+	case ScopeHost:
+		return ScopeHost, nil
+	}
+	return "", fmt.Errorf("invalid scope %q (expected auto, cgroup or host)", s)
+}
+
+// Register enum in OpenAPI specification
+// https://github.com/danielgtaylor/huma/issues/621
+func (u Scope) Schema(r huma.Registry) *huma.Schema {
+	if r.Map()["Scope"] == nil {
+		schemaRef := r.Schema(reflect.TypeOf(""), true, "Scope")
+		schemaRef.Title = "Scope"
+		schemaRef.Enum = append(schemaRef.Enum, []any{
+			string(ScopeAuto),
+			string(ScopeCgroup),
+			string(ScopeHost),
+		}...)
+		r.Map()["Scope"] = schemaRef
+	}
+	return &huma.Schema{Ref: "#/components/schemas/Scope"}
+}
+
+// SetScope selects whether memory, CPU and process figures are relative to
+// the host or to the cgroup dgop runs in. Auto uses the cgroup only for the
+// resources it actually limits.
+func (self *GopsUtil) SetScope(scope Scope) {
+	self.scope = scope
+}
+
+// WithScope returns a clone of self that reports against scope.
+func (self *GopsUtil) WithScope(scope Scope) *GopsUtil {
+	if scope == "" || scope == self.scope {
+		return self
+	}
+	scoped := self.clone()
+	scoped.scope = scope
+	return scoped
+}
+
+// cgroupLimits describes the cgroup v2 limits that apply to this process.
+// MemoryDir and CPUDir name the cgroup whose usage is reported for that
+// resource and are empty when the host figures should be used instead.
+type cgroupLimits struct {
+	Path      string
+	MemoryDir string
+	MemoryMax uint64
+	CPUDir    string
+	CPULimit  float64
+}
+
+type cgroupMemory struct {
+	Current     uint64
+	Stat        map[string]uint64
+	SwapCurrent uint64
+	SwapMax     uint64
+	HasSwap     bool
+}
+
+func (self *GopsUtil) cgroupLimits() *cgroupLimits {
+	if self.scope == ScopeHost {
+		return nil
+	}
+	return self.detectCgroupLimits(self.scope == ScopeCgroup)
+}
+
+// cpuCount returns the number of CPUs the cgroup may use, falling back to
+// the host count when only a cpuset or nothing at all restricts it.
+func (cg *cgroupLimits) cpuCount(hostCPUs int) float64 {
+	if cg.CPULimit > 0 {
+		return cg.CPULimit
+	}
+	return float64(hostCPUs)
+}
+
+// memoryLimit returns the cgroup memory limit in bytes, capped at the host total.
+func (cg *cgroupLimits) memoryLimit(hostTotal uint64) uint64 {
+	if cg.MemoryMax > 0 && (hostTotal == 0 || cg.MemoryMax < hostTotal) {
+		return cg.MemoryMax
+	}
+	return hostTotal
+}
+
+// applyCgroupMemory rewrites host memory figures (kB) relative to the cgroup.
+// Used excludes inactive file cache, matching what the kernel reclaims first
+// when the cgroup approaches memory.max.
+func applyCgroupMemory(info *models.MemoryInfo, mem cgroupMemory, cg *cgroupLimits) {
+	total := cg.memoryLimit(info.Total*1024) / 1024
+	current := mem.Current / 1024
+
+	used := current
+	if inactive := mem.Stat["inactive_file"] / 1024; used > inactive {
+		used -= inactive
+	} else {
+		used = 0
+	}
+
+	info.Total = total
+	info.Used = used
+	info.Free = saturatingSub(total, current)
+	info.Available = saturatingSub(total, used)
+	info.Buffers = 0
+	info.Cached = mem.Stat["file"] / 1024
+	info.SReclaimable = mem.Stat["slab_reclaimable"] / 1024
+	info.Shared = mem.Stat["shmem"] / 1024
+	info.ZfsArcSize = 0
+	info.UsedPercent = 0
+	if total > 0 {
+		info.UsedPercent = float64(used) / float64(total) * 100
+	}
+
+	if mem.HasSwap {
+		if mem.SwapMax > 0 && mem.SwapMax/1024 < info.SwapTotal {
+			info.SwapTotal = mem.SwapMax / 1024
+		}
+		info.SwapFree = saturatingSub(info.SwapTotal, mem.SwapCurrent/1024)
+	}
+
+	info.Scope = string(ScopeCgroup)
+	info.Cgroup = cg.Path
+}
+
+// cgroupCPUPercent converts a cpu.stat usage_usec delta into a percentage of
+// the CPUs the cgroup is allowed to use.
+func cgroupCPUPercent(prevUsec, currUsec uint64, wallSeconds, cpus float64) float64 {
+	if currUsec < prevUsec || wallSeconds <= 0 || cpus <= 0 {
+		return 0
+	}
+	percent := float64(currUsec-prevUsec) / 1e6 / wallSeconds / cpus * 100
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return 0
+}
+
+// parseCgroupPath returns the unified hierarchy path from /proc/self/cgroup.
+func parseCgroupPath(content string) (string, bool) {
+	for _, line := range strings.Split(content, "\n") {
+		if path, ok := strings.CutPrefix(line, "0::"); ok {
+			return strings.TrimSpace(path), true
+		}
+	}
+	return "", false
+}
+
+// parseCgroupMax parses single-value limit files such as memory.max, where
+// "max" means unlimited.
+func parseCgroupMax(content string) (uint64, bool) {
+	value := strings.TrimSpace(content)
+	if value == "" || value == "max" {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseCPUMax parses cpu.max ("$QUOTA $PERIOD") into a number of CPUs, or 0
+// when the quota is "max".
+func parseCPUMax(content string) float64 {
+	fields := strings.Fields(content)
+	if len(fields) != 2 || fields[0] == "max" {
+		return 0
+	}
+	quota, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	period, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil || period <= 0 {
+		return 0
+	}
+	return quota / period
+}
+
+// parseFlatKeyed parses "key value" files such as memory.stat and cpu.stat.
+func parseFlatKeyed(content string) map[string]uint64 {
+	values := make(map[string]uint64)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values
+}
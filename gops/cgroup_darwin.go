@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import "fmt"
+
+func (self *GopsUtil) detectCgroupLimits(force bool) *cgroupLimits {
+	return nil
+}
+
+func (self *GopsUtil) readCgroupCPUUsage(dir string) (uint64, error) {
+	return 0, fmt.Errorf("cgroups are not supported on darwin")
+}
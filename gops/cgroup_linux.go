@@ -0,0 +1,107 @@
+//go:build linux
+
+package gops
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const (
+	cgroupRoot         = "/sys/fs/cgroup"
+	procSelfCgroupPath = "/proc/self/cgroup"
+)
+
+func (self *GopsUtil) detectCgroupLimits(force bool) *cgroupLimits {
+	onlineCPUs, _ := parseCPUList(self.readSysfsString(filepath.Join(cpuSysfsPath, "online")))
+	return self.readCgroupLimitsFrom(cgroupRoot, procSelfCgroupPath, len(onlineCPUs), force)
+}
+
+// readCgroupLimitsFrom finds the tightest memory and CPU limits between the
+// process's cgroup and the hierarchy root, since a parent slice's limit
+// applies to everything below it. Only cgroup v2 is supported.
+func (self *GopsUtil) readCgroupLimitsFrom(root, selfCgroup string, onlineCPUs int, force bool) *cgroupLimits {
+	if _, err := self.fs.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return nil
+	}
+	data, err := self.fs.ReadFile(selfCgroup)
+	if err != nil {
+		return nil
+	}
+	path, ok := parseCgroupPath(string(data))
+	if !ok {
+		return nil
+	}
+
+	leaf := filepath.Join(root, path)
+	limits := &cgroupLimits{Path: path}
+
+	for dir := leaf; ; dir = filepath.Dir(dir) {
+		if max, ok := parseCgroupMax(self.readSysfsString(filepath.Join(dir, "memory.max"))); ok {
+			if limits.MemoryMax == 0 || max < limits.MemoryMax {
+				limits.MemoryMax = max
+				limits.MemoryDir = dir
+			}
+		}
+		if cpus := parseCPUMax(self.readSysfsString(filepath.Join(dir, "cpu.max"))); cpus > 0 {
+			if limits.CPULimit == 0 || cpus < limits.CPULimit {
+				limits.CPULimit = cpus
+				limits.CPUDir = dir
+			}
+		}
+		if dir == root || !strings.HasPrefix(dir, root) {
+			break
+		}
+	}
+
+	if cpus, err := parseCPUList(self.readSysfsString(filepath.Join(leaf, "cpuset.cpus.effective"))); err == nil && len(cpus) > 0 {
+		if len(cpus) < onlineCPUs && (limits.CPULimit == 0 || float64(len(cpus)) < limits.CPULimit) {
+			limits.CPULimit = float64(len(cpus))
+			limits.CPUDir = leaf
+		}
+	}
+
+	if force {
+		if limits.MemoryDir == "" {
+			limits.MemoryDir = leaf
+		}
+		if limits.CPUDir == "" {
+			limits.CPUDir = leaf
+		}
+	}
+
+	if limits.MemoryDir == "" && limits.CPUDir == "" {
+		return nil
+	}
+	return limits
+}
+
+func (self *GopsUtil) readCgroupMemory(dir string) (cgroupMemory, error) {
+	mem := cgroupMemory{}
+
+	current, err := self.readSysfsUint(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return mem, err
+	}
+	mem.Current = current
+
+	if stat, err := self.fs.ReadFile(filepath.Join(dir, "memory.stat")); err == nil {
+		mem.Stat = parseFlatKeyed(string(stat))
+	}
+
+	if swapCurrent, err := self.readSysfsUint(filepath.Join(dir, "memory.swap.current")); err == nil {
+		mem.HasSwap = true
+		mem.SwapCurrent = swapCurrent
+		mem.SwapMax, _ = parseCgroupMax(self.readSysfsString(filepath.Join(dir, "memory.swap.max")))
+	}
+
+	return mem, nil
+}
+
+func (self *GopsUtil) readCgroupCPUUsage(dir string) (uint64, error) {
+	data, err := self.fs.ReadFile(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return 0, err
+	}
+	return parseFlatKeyed(string(data))["usage_usec"], nil
+}
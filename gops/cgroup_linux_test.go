@@ -0,0 +1,92 @@
+//go:build linux
+
+package gops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCgroupLimitsFrom(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/proc/self/cgroup":                                             "0::/system.slice/app.service",
+		"/sys/fs/cgroup/cgroup.controllers":                             "cpuset cpu memory",
+		"/sys/fs/cgroup/system.slice/memory.max":                        "2147483648",
+		"/sys/fs/cgroup/system.slice/cpu.max":                           "max 100000",
+		"/sys/fs/cgroup/system.slice/app.service/memory.max":            "max",
+		"/sys/fs/cgroup/system.slice/app.service/cpu.max":               "150000 100000",
+		"/sys/fs/cgroup/system.slice/app.service/cpu.stat":              "usage_usec 4200",
+		"/sys/fs/cgroup/system.slice/app.service/cpuset.cpus.effective": "0-7",
+	})
+
+	limits := gops.readCgroupLimitsFrom(cgroupRoot, procSelfCgroupPath, 8, false)
+	require.NotNil(t, limits)
+	assert.Equal(t, "/system.slice/app.service", limits.Path)
+	assert.Equal(t, uint64(2147483648), limits.MemoryMax)
+	assert.Equal(t, "/sys/fs/cgroup/system.slice", limits.MemoryDir)
+	assert.Equal(t, 1.5, limits.CPULimit)
+	assert.Equal(t, "/sys/fs/cgroup/system.slice/app.service", limits.CPUDir)
+
+	usage, err := gops.readCgroupCPUUsage(limits.CPUDir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4200), usage)
+}
+
+func TestReadCgroupLimitsFromCpuset(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/proc/self/cgroup":                           "0::/pinned",
+		"/sys/fs/cgroup/cgroup.controllers":           "cpuset cpu memory",
+		"/sys/fs/cgroup/pinned/cpuset.cpus.effective": "2-3",
+	})
+
+	limits := gops.readCgroupLimitsFrom(cgroupRoot, procSelfCgroupPath, 8, false)
+	require.NotNil(t, limits)
+	assert.Equal(t, 2.0, limits.CPULimit)
+	assert.Empty(t, limits.MemoryDir)
+}
+
+func TestReadCgroupLimitsFromUnlimited(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/proc/self/cgroup":                    "0::/user.slice",
+		"/sys/fs/cgroup/cgroup.controllers":    "cpu memory",
+		"/sys/fs/cgroup/user.slice/memory.max": "max",
+		"/sys/fs/cgroup/user.slice/cpu.max":    "max 100000",
+	})
+
+	assert.Nil(t, gops.readCgroupLimitsFrom(cgroupRoot, procSelfCgroupPath, 8, false))
+
+	limits := gops.readCgroupLimitsFrom(cgroupRoot, procSelfCgroupPath, 8, true)
+	require.NotNil(t, limits)
+	assert.Equal(t, "/sys/fs/cgroup/user.slice", limits.MemoryDir)
+	assert.Equal(t, "/sys/fs/cgroup/user.slice", limits.CPUDir)
+	assert.Equal(t, 0.0, limits.CPULimit)
+}
+
+func TestReadCgroupLimitsFromCgroupV1(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{"/proc/self/cgroup": "4:memory:/docker/abc"})
+
+	assert.Nil(t, gops.readCgroupLimitsFrom(cgroupRoot, procSelfCgroupPath, 8, true))
+}
+
+func TestReadCgroupMemory(t *testing.T) {
+	dir := "/sys/fs/cgroup/user.slice"
+	gops, _ := newFixtureGops(map[string]string{
+		dir + "/memory.current":      "1048576",
+		dir + "/memory.stat":         "anon 524288\nfile 262144\ninactive_file 131072",
+		dir + "/memory.swap.current": "4096",
+		dir + "/memory.swap.max":     "max",
+	})
+
+	mem, err := gops.readCgroupMemory(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1048576), mem.Current)
+	assert.Equal(t, uint64(131072), mem.Stat["inactive_file"])
+	assert.True(t, mem.HasSwap)
+	assert.Equal(t, uint64(4096), mem.SwapCurrent)
+	assert.Equal(t, uint64(0), mem.SwapMax)
+
+	_, err = gops.readCgroupMemory("/sys/fs/cgroup/system.slice")
+	assert.Error(t, err)
+}
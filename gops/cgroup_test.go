@@ -0,0 +1,113 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScope(t *testing.T) {
+	for input, want := range map[string]Scope{"": ScopeAuto, "auto": ScopeAuto, "Cgroup": ScopeCgroup, " host ": ScopeHost} {
+		scope, err := ParseScope(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, scope, input)
+	}
+
+	_, err := ParseScope("container")
+	assert.Error(t, err)
+}
+
+func TestWithScopeSharesCaches(t *testing.T) {
+	util := NewGopsUtil()
+	scoped := util.WithScope(ScopeHost)
+
+	assert.Equal(t, ScopeHost, scoped.scope)
+	assert.Equal(t, Scope(""), util.scope)
+	assert.Same(t, util.procStatic, scoped.procStatic)
+	assert.Same(t, util, util.WithScope(""))
+}
+
+func TestParseCgroupFiles(t *testing.T) {
+	path, ok := parseCgroupPath("1:name=systemd:/\n0::/system.slice/dgop.service\n")
+	assert.True(t, ok)
+	assert.Equal(t, "/system.slice/dgop.service", path)
+
+	_, ok = parseCgroupPath("4:memory:/docker/abc\n")
+	assert.False(t, ok)
+
+	max, ok := parseCgroupMax("2147483648\n")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2147483648), max)
+	_, ok = parseCgroupMax("max\n")
+	assert.False(t, ok)
+
+	assert.Equal(t, 1.5, parseCPUMax("150000 100000\n"))
+	assert.Equal(t, 0.0, parseCPUMax("max 100000\n"))
+
+	stat := parseFlatKeyed("usage_usec 123\nnr_throttled 4\nbogus\n")
+	assert.Equal(t, map[string]uint64{"usage_usec": 123, "nr_throttled": 4}, stat)
+}
+
+func TestApplyCgroupMemory(t *testing.T) {
+	info := &models.MemoryInfo{Total: 16 * 1024 * 1024, SwapTotal: 8 * 1024 * 1024, SwapFree: 8 * 1024 * 1024, Buffers: 100, ZfsArcSize: 50}
+	cg := &cgroupLimits{Path: "/docker/abc", MemoryMax: 2 << 30}
+	mem := cgroupMemory{
+		Current: 1 << 30,
+		Stat: map[string]uint64{
+			"inactive_file":    256 << 20,
+			"file":             512 << 20,
+			"slab_reclaimable": 16 << 20,
+			"shmem":            8 << 20,
+		},
+		HasSwap:     true,
+		SwapCurrent: 100 << 20,
+		SwapMax:     1 << 30,
+	}
+
+	applyCgroupMemory(info, mem, cg)
+
+	assert.Equal(t, uint64(2*1024*1024), info.Total)
+	assert.Equal(t, uint64(768*1024), info.Used)
+	assert.Equal(t, uint64(1024*1024), info.Free)
+	assert.Equal(t, uint64(1280*1024), info.Available)
+	assert.Equal(t, uint64(512*1024), info.Cached)
+	assert.Equal(t, uint64(16*1024), info.SReclaimable)
+	assert.Equal(t, uint64(8*1024), info.Shared)
+	assert.Equal(t, uint64(0), info.Buffers)
+	assert.Equal(t, uint64(0), info.ZfsArcSize)
+	assert.InDelta(t, 37.5, info.UsedPercent, 0.001)
+	assert.Equal(t, uint64(1024*1024), info.SwapTotal)
+	assert.Equal(t, uint64(924*1024), info.SwapFree)
+	assert.Equal(t, "cgroup", info.Scope)
+	assert.Equal(t, "/docker/abc", info.Cgroup)
+}
+
+func TestApplyCgroupMemoryUnlimited(t *testing.T) {
+	info := &models.MemoryInfo{Total: 4096, SwapTotal: 1024, SwapFree: 1024}
+	applyCgroupMemory(info, cgroupMemory{Current: 1024 * 1024}, &cgroupLimits{Path: "/"})
+
+	assert.Equal(t, uint64(4096), info.Total)
+	assert.Equal(t, uint64(1024), info.Used)
+	assert.Equal(t, uint64(1024), info.SwapFree)
+}
+
+func TestCgroupCPUPercent(t *testing.T) {
+	// 1.5s of CPU time over 1s of wall time on a 2 CPU quota
+	assert.Equal(t, 75.0, cgroupCPUPercent(1_000_000, 2_500_000, 1, 2))
+	assert.Equal(t, 100.0, cgroupCPUPercent(0, 5_000_000, 1, 2))
+	assert.Equal(t, 0.0, cgroupCPUPercent(5, 1, 1, 2))
+	assert.Equal(t, 0.0, cgroupCPUPercent(0, 1, 0, 2))
+}
+
+func TestCgroupLimitsFallbacks(t *testing.T) {
+	cg := &cgroupLimits{}
+	assert.Equal(t, 8.0, cg.cpuCount(8))
+	assert.Equal(t, uint64(1000), cg.memoryLimit(1000))
+
+	cg = &cgroupLimits{CPULimit: 0.5, MemoryMax: 500}
+	assert.Equal(t, 0.5, cg.cpuCount(8))
+	assert.Equal(t, uint64(500), cg.memoryLimit(1000))
+	assert.Equal(t, uint64(500), cg.memoryLimit(0))
+}
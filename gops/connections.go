@@ -0,0 +1,281 @@
+package gops
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// Socket tables under /proc/net, in the order they are reported
+var connectionProtocols = []string{"tcp", "tcp6", "udp", "udp6", "unix"}
+
+// TCP states from include/net/tcp_states.h
+var tcpStates = map[uint64]string{
+	0x01: "ESTABLISHED",
+	0x02: "SYN_SENT",
+	0x03: "SYN_RECV",
+	0x04: "FIN_WAIT1",
+	0x05: "FIN_WAIT2",
+	0x06: "TIME_WAIT",
+	0x07: "CLOSE",
+	0x08: "CLOSE_WAIT",
+	0x09: "LAST_ACK",
+	0x0A: "LISTEN",
+	0x0B: "CLOSING",
+	0x0C: "NEW_SYN_RECV",
+}
+
+// unix socket states from include/uapi/linux/net.h, plus __SO_ACCEPTCON
+const unixAcceptCon = 0x10000
+
+var unixStates = map[uint64]string{
+	0x01: "UNCONN",
+	0x02: "CONNECTING",
+	0x03: "ESTABLISHED",
+	0x04: "DISCONNECTING",
+}
+
+type ConnectionFilter struct {
+	Protocol string
+	State    string
+	Port     int
+	PID      int32
+}
+
+func (self *GopsUtil) GetConnections(filter ConnectionFilter) (*models.ConnectionsResponse, error) {
+	conns, err := self.readConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := filterConnections(conns, filter)
+	sortConnections(filtered)
+
+	return &models.ConnectionsResponse{
+		Connections: filtered,
+		Summary:     summarizeConnections(filtered),
+	}, nil
+}
+
+func filterConnections(conns []*models.Connection, filter ConnectionFilter) []*models.Connection {
+	filtered := make([]*models.Connection, 0, len(conns))
+	for _, conn := range conns {
+		if filter.Protocol != "" && !matchesProtocol(conn.Protocol, filter.Protocol) {
+			continue
+		}
+		if filter.State != "" && !strings.EqualFold(conn.State, filter.State) {
+			continue
+		}
+		if filter.Port != 0 && conn.LocalPort != filter.Port && conn.RemotePort != filter.Port {
+			continue
+		}
+		if filter.PID != 0 && conn.PID != filter.PID {
+			continue
+		}
+		filtered = append(filtered, conn)
+	}
+	return filtered
+}
+
+// matchesProtocol lets "tcp" match tcp6 too, the same way ss -t shows both families
+func matchesProtocol(protocol, want string) bool {
+	want = strings.ToLower(want)
+	return protocol == want || strings.TrimSuffix(protocol, "6") == want
+}
+
+func sortConnections(conns []*models.Connection) {
+	order := make(map[string]int, len(connectionProtocols))
+	for i, proto := range connectionProtocols {
+		order[proto] = i
+	}
+
+	sort.SliceStable(conns, func(i, j int) bool {
+		a, b := conns[i], conns[j]
+		if a.Protocol != b.Protocol {
+			return order[a.Protocol] < order[b.Protocol]
+		}
+		if a.State != b.State {
+			// Listeners first, like ss -l output ahead of established sockets
+			if a.State == "LISTEN" || b.State == "LISTEN" {
+				return a.State == "LISTEN"
+			}
+			return a.State < b.State
+		}
+		if a.LocalPort != b.LocalPort {
+			return a.LocalPort < b.LocalPort
+		}
+		return a.Inode < b.Inode
+	})
+}
+
+func summarizeConnections(conns []*models.Connection) *models.ConnectionSummary {
+	summary := &models.ConnectionSummary{
+		Total:     len(conns),
+		Protocols: make(map[string]int),
+		TCPStates: make(map[string]int),
+	}
+	for _, conn := range conns {
+		summary.Protocols[conn.Protocol]++
+		if strings.HasPrefix(conn.Protocol, "tcp") {
+			summary.TCPStates[conn.State]++
+		}
+	}
+	return summary
+}
+
+// parseProcNetSockets parses /proc/net/{tcp,tcp6,udp,udp6}
+func parseProcNetSockets(data, protocol string) []*models.Connection {
+	var conns []*models.Connection
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Scan() // header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		localAddr, localPort, err := parseProcNetAddr(fields[1])
+		if err != nil {
+			continue
+		}
+		remoteAddr, remotePort, err := parseProcNetAddr(fields[2])
+		if err != nil {
+			continue
+		}
+		st, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			continue
+		}
+		txQueue, rxQueue := parseSocketQueues(fields[4])
+		uid, err := strconv.ParseUint(fields[7], 10, 32)
+		if err != nil {
+			continue
+		}
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		uid32 := uint32(uid)
+		conns = append(conns, &models.Connection{
+			Protocol:   protocol,
+			LocalAddr:  localAddr,
+			LocalPort:  localPort,
+			RemoteAddr: remoteAddr,
+			RemotePort: remotePort,
+			State:      socketState(protocol, st),
+			TxQueue:    txQueue,
+			RxQueue:    rxQueue,
+			UID:        &uid32,
+			Inode:      inode,
+		})
+	}
+
+	return conns
+}
+
+// socketState names a TCP state; UDP reuses the numbers, where an unbound
+// socket sits in CLOSE, which ss shows as UNCONN
+func socketState(protocol string, st uint64) string {
+	if strings.HasPrefix(protocol, "udp") && st == 0x07 {
+		return "UNCONN"
+	}
+	if state, ok := tcpStates[st]; ok {
+		return state
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", st)
+}
+
+func parseSocketQueues(field string) (uint64, uint64) {
+	tx, rx, _ := strings.Cut(field, ":")
+	txQueue, _ := strconv.ParseUint(tx, 16, 64)
+	rxQueue, _ := strconv.ParseUint(rx, 16, 64)
+	return txQueue, rxQueue
+}
+
+// parseProcNetAddr decodes "0100007F:0035"; the address is stored as
+// 32-bit words in host byte order, the port in big endian hex
+func parseProcNetAddr(field string) (string, int, error) {
+	addrHex, portHex, ok := strings.Cut(field, ":")
+	if !ok {
+		return "", 0, fmt.Errorf("invalid socket address %q", field)
+	}
+
+	raw, err := hex.DecodeString(addrHex)
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return "", 0, fmt.Errorf("invalid socket address %q", field)
+	}
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid socket port %q", field)
+	}
+
+	ip := make(net.IP, len(raw))
+	for i := 0; i < len(raw); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.LittleEndian.Uint32(raw[i:]))
+	}
+
+	return ip.String(), int(port), nil
+}
+
+// parseProcNetUnix parses /proc/net/unix:
+// Num RefCount Protocol Flags Type St Inode [Path]
+func parseProcNetUnix(data string) []*models.Connection {
+	var conns []*models.Connection
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Scan() // header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 7 {
+			continue
+		}
+
+		flags, err := strconv.ParseUint(fields[3], 16, 32)
+		if err != nil {
+			continue
+		}
+		st, err := strconv.ParseUint(fields[5], 16, 8)
+		if err != nil {
+			continue
+		}
+		inode, err := strconv.ParseUint(fields[6], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		state := unixStates[st]
+		if flags&unixAcceptCon != 0 {
+			state = "LISTEN"
+		}
+
+		conn := &models.Connection{
+			Protocol: "unix",
+			State:    state,
+			Inode:    inode,
+		}
+		if len(fields) > 7 {
+			conn.Path = strings.Join(fields[7:], " ")
+		}
+		conns = append(conns, conn)
+	}
+
+	return conns
+}
+
+// parseSocketInode extracts the inode from a /proc/<pid>/fd link such as "socket:[12345]"
+func parseSocketInode(link string) (uint64, bool) {
+	if !strings.HasPrefix(link, "socket:[") || !strings.HasSuffix(link, "]") {
+		return 0, false
+	}
+	inode, err := strconv.ParseUint(link[len("socket:["):len(link)-1], 10, 64)
+	return inode, err == nil
+}
@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readConnections() ([]*models.Connection, error) {
+	return nil, fmt.Errorf("connection tables are not supported on darwin")
+}
@@ -0,0 +1,97 @@
+//go:build linux
+
+package gops
+
+import (
+	"path/filepath"
+	"strconv"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readConnections() ([]*models.Connection, error) {
+	return self.readConnectionsFrom("/proc")
+}
+
+func (self *GopsUtil) readConnectionsFrom(procRoot string) ([]*models.Connection, error) {
+	var conns []*models.Connection
+	var lastErr error
+	read := 0
+
+	for _, proto := range connectionProtocols {
+		data, err := self.fs.ReadFile(filepath.Join(procRoot, "net", proto))
+		if err != nil {
+			// tcp6/udp6 are missing when IPv6 is disabled
+			lastErr = err
+			continue
+		}
+		read++
+
+		if proto == "unix" {
+			conns = append(conns, parseProcNetUnix(string(data))...)
+		} else {
+			conns = append(conns, parseProcNetSockets(string(data), proto)...)
+		}
+	}
+	if read == 0 {
+		return nil, lastErr
+	}
+
+	owners := self.readSocketOwners(procRoot)
+	for _, conn := range conns {
+		if owner, ok := owners[conn.Inode]; ok {
+			conn.PID = owner.pid
+			conn.Command = owner.command
+		}
+	}
+
+	if conns == nil {
+		conns = []*models.Connection{}
+	}
+	return conns, nil
+}
+
+type socketOwner struct {
+	pid     int32
+	command string
+}
+
+// readSocketOwners maps socket inodes to the first process holding them open.
+// Without root only the caller's own processes are visible.
+func (self *GopsUtil) readSocketOwners(procRoot string) map[uint64]socketOwner {
+	owners := make(map[uint64]socketOwner)
+
+	for _, entry := range self.listSysfsDir(procRoot) {
+		pid, err := strconv.ParseInt(entry, 10, 32)
+		if err != nil {
+			continue
+		}
+
+		fdDir := filepath.Join(procRoot, entry, "fd")
+		fds, err := self.fs.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+
+		var command string
+		for _, fd := range fds {
+			link, err := self.fs.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil {
+				continue
+			}
+			inode, ok := parseSocketInode(link)
+			if !ok {
+				continue
+			}
+			if _, seen := owners[inode]; seen {
+				continue
+			}
+			if command == "" {
+				command = self.readSysfsString(filepath.Join(procRoot, entry, "comm"))
+			}
+			owners[inode] = socketOwner{pid: int32(pid), command: command}
+		}
+	}
+
+	return owners
+}
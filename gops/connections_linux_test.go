@@ -0,0 +1,40 @@
+//go:build linux
+
+package gops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConnectionsFrom(t *testing.T) {
+	gops, fsys := newFixtureGops(map[string]string{
+		"/proc/net/tcp":   procNetTCP,
+		"/proc/net/unix":  procNetUnix,
+		"/proc/812/comm":  "sshd",
+		"/proc/1044/comm": "dbus-daemon",
+		"/proc/self/comm": "dgop",
+	})
+	fsys.symlink("/proc/812/fd/3", "socket:[41234]")
+	fsys.symlink("/proc/812/fd/1", "/dev/null")
+	fsys.symlink("/proc/1044/fd/5", "socket:[24001]")
+	fsys.symlink("/proc/1044/fd/6", "pipe:[99]")
+
+	conns, err := gops.readConnectionsFrom("/proc")
+	require.NoError(t, err)
+	require.Len(t, conns, 6)
+
+	owned := map[uint64]string{}
+	for _, conn := range conns {
+		if conn.PID != 0 {
+			owned[conn.Inode] = conn.Command
+		}
+	}
+	assert.Equal(t, map[uint64]string{41234: "sshd", 24001: "dbus-daemon"}, owned)
+
+	empty, _ := newFixtureGops(nil)
+	_, err = empty.readConnectionsFrom("/proc")
+	assert.Error(t, err)
+}
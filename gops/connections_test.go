@@ -0,0 +1,157 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const procNetTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:0035 00000000:0000 0A 00000000:00000000 00:00000000 00000000   101        0 20512 1 0000000000000000 100 0 0 10 0
+   1: 0F02000A:0016 0102000A:D431 01 00000024:00000000 01:00000019 00000000     0        0 41234 4 0000000000000000 20 4 29 10 -1
+   2: 0F02000A:9C40 22D8B85D:01BB 06 00000000:00000000 03:00001770 00000000     0        0 0 3 0000000000000000
+`
+
+const procNetTCP6 = `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000001000000:1F90 00000000000000000000000000000000:0000 0A 00000000:00000005 00:00000000 00000000  1000        0 33001 1 0000000000000000 100 0 0 10 0
+`
+
+const procNetUDP = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  512: 00000000:0044 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 18001 2 0000000000000000 0
+`
+
+const procNetUnix = `Num       RefCount Protocol Flags    Type St Inode Path
+0000000000000000: 00000002 00000000 00010000 0001 01 24001 /run/dbus/system_bus_socket
+0000000000000000: 00000003 00000000 00000000 0001 03 24002
+0000000000000000: 00000002 00000000 00000000 0002 01 24003 @/tmp/.X11-unix/X0
+`
+
+func TestParseProcNetAddr(t *testing.T) {
+	tests := []struct {
+		field string
+		addr  string
+		port  int
+	}{
+		{"0100007F:0035", "127.0.0.1", 53},
+		{"00000000:0000", "0.0.0.0", 0},
+		{"0F02000A:0016", "10.0.2.15", 22},
+		{"00000000000000000000000001000000:1F90", "::1", 8080},
+		{"0000000000000000FFFF00000100007F:01BB", "127.0.0.1", 443},
+		{"B80D0120000000000000000001000000:0050", "2001:db8::1", 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			addr, port, err := parseProcNetAddr(tt.field)
+			require.NoError(t, err)
+			assert.Equal(t, tt.addr, addr)
+			assert.Equal(t, tt.port, port)
+		})
+	}
+
+	for _, bad := range []string{"", "0100007F", "0100:0035", "zz00007F:0035", "0100007F:XYZ"} {
+		_, _, err := parseProcNetAddr(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseProcNetSockets(t *testing.T) {
+	conns := parseProcNetSockets(procNetTCP, "tcp")
+	require.Len(t, conns, 3)
+
+	listen := conns[0]
+	assert.Equal(t, "127.0.0.1", listen.LocalAddr)
+	assert.Equal(t, 53, listen.LocalPort)
+	assert.Equal(t, "LISTEN", listen.State)
+	require.NotNil(t, listen.UID)
+	assert.Equal(t, uint32(101), *listen.UID)
+	assert.Equal(t, uint64(20512), listen.Inode)
+
+	ssh := conns[1]
+	assert.Equal(t, "10.0.2.1", ssh.RemoteAddr)
+	assert.Equal(t, 54321, ssh.RemotePort)
+	assert.Equal(t, "ESTABLISHED", ssh.State)
+	assert.Equal(t, uint64(0x24), ssh.TxQueue)
+
+	assert.Equal(t, "TIME_WAIT", conns[2].State)
+
+	v6 := parseProcNetSockets(procNetTCP6, "tcp6")
+	require.Len(t, v6, 1)
+	assert.Equal(t, "::1", v6[0].LocalAddr)
+	assert.Equal(t, uint64(5), v6[0].RxQueue)
+
+	udp := parseProcNetSockets(procNetUDP, "udp")
+	require.Len(t, udp, 1)
+	assert.Equal(t, "UNCONN", udp[0].State)
+	assert.Equal(t, 68, udp[0].LocalPort)
+}
+
+func TestParseProcNetUnix(t *testing.T) {
+	conns := parseProcNetUnix(procNetUnix)
+	require.Len(t, conns, 3)
+
+	assert.Equal(t, "LISTEN", conns[0].State)
+	assert.Equal(t, "/run/dbus/system_bus_socket", conns[0].Path)
+	assert.Equal(t, "ESTABLISHED", conns[1].State)
+	assert.Empty(t, conns[1].Path)
+	assert.Equal(t, "UNCONN", conns[2].State)
+	assert.Equal(t, "@/tmp/.X11-unix/X0", conns[2].Path)
+	assert.Nil(t, conns[0].UID)
+}
+
+func TestParseSocketInode(t *testing.T) {
+	inode, ok := parseSocketInode("socket:[41234]")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(41234), inode)
+
+	for _, link := range []string{"/dev/null", "pipe:[1234]", "socket:[]", "socket:[abc]"} {
+		_, ok := parseSocketInode(link)
+		assert.False(t, ok, link)
+	}
+}
+
+func TestFilterConnections(t *testing.T) {
+	var conns []*models.Connection
+	conns = append(conns, parseProcNetSockets(procNetTCP, "tcp")...)
+	conns = append(conns, parseProcNetSockets(procNetTCP6, "tcp6")...)
+	conns = append(conns, parseProcNetSockets(procNetUDP, "udp")...)
+	conns = append(conns, parseProcNetUnix(procNetUnix)...)
+	conns[1].PID = 812
+
+	assert.Len(t, filterConnections(conns, ConnectionFilter{}), 8)
+	assert.Len(t, filterConnections(conns, ConnectionFilter{Protocol: "tcp"}), 4)
+	assert.Len(t, filterConnections(conns, ConnectionFilter{Protocol: "TCP6"}), 1)
+	assert.Len(t, filterConnections(conns, ConnectionFilter{State: "listen"}), 3)
+	assert.Len(t, filterConnections(conns, ConnectionFilter{Protocol: "tcp", State: "LISTEN"}), 2)
+
+	byPort := filterConnections(conns, ConnectionFilter{Port: 54321})
+	require.Len(t, byPort, 1)
+	assert.Equal(t, 22, byPort[0].LocalPort)
+
+	byPID := filterConnections(conns, ConnectionFilter{PID: 812})
+	require.Len(t, byPID, 1)
+	assert.Equal(t, "ESTABLISHED", byPID[0].State)
+}
+
+func TestSortAndSummarizeConnections(t *testing.T) {
+	var conns []*models.Connection
+	conns = append(conns, parseProcNetUnix(procNetUnix)...)
+	conns = append(conns, parseProcNetSockets(procNetUDP, "udp")...)
+	conns = append(conns, parseProcNetSockets(procNetTCP, "tcp")...)
+
+	sortConnections(conns)
+
+	assert.Equal(t, "tcp", conns[0].Protocol)
+	assert.Equal(t, "LISTEN", conns[0].State)
+	assert.Equal(t, "ESTABLISHED", conns[1].State)
+	assert.Equal(t, "udp", conns[3].Protocol)
+	assert.Equal(t, "unix", conns[4].Protocol)
+	assert.Equal(t, "LISTEN", conns[4].State)
+
+	summary := summarizeConnections(conns)
+	assert.Equal(t, 7, summary.Total)
+	assert.Equal(t, map[string]int{"tcp": 3, "udp": 1, "unix": 3}, summary.Protocols)
+	assert.Equal(t, map[string]int{"LISTEN": 1, "ESTABLISHED": 1, "TIME_WAIT": 1}, summary.TCPStates)
+}
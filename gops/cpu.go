@@ -21,6 +21,8 @@ type CPUTracker struct {
 
 	freqLastRead time.Time
 	freqValue    float64
+	coreFreqs    []models.CPUCoreFreq
+	freqDriver   string
 
 	mu sync.RWMutex
 }
@@ -50,6 +52,7 @@ func (self *GopsUtil) GetCPUInfoWithCursor(cursor string) (*models.CPUInfo, erro
 	now := time.Now()
 	if now.Sub(cpuTracker.freqLastRead) > 2*time.Second {
 		cpuTracker.freqValue = getCurrentCPUFreq()
+		cpuTracker.coreFreqs, cpuTracker.freqDriver = self.getCoreFrequencies()
 		cpuTracker.freqLastRead = now
 	}
 	if cpuTracker.freqValue > 0 {
@@ -58,6 +61,9 @@ func (self *GopsUtil) GetCPUInfoWithCursor(cursor string) (*models.CPUInfo, erro
 		cpuInfo.Frequency = cpuTracker.cpuFreq
 	}
 
+	cpuInfo.FreqDriver = cpuTracker.freqDriver
+	cpuInfo.CoreFreqs = cpuTracker.coreFreqs
+
 	cpuInfo.Count = cpuTracker.cpuCount
 	cpuInfo.Model = cpuTracker.cpuModel
 
@@ -89,6 +95,23 @@ func (self *GopsUtil) GetCPUInfoWithCursor(cursor string) (*models.CPUInfo, erro
 		}
 	}
 
+	cpuInfo.CoreThrottle, cpuInfo.PackageThrottle = self.getThrottleCounters()
+
+	// In cgroup scope, usage is measured against the cgroup's CPU allowance
+	var cgroupDir string
+	var cgroupUsage uint64
+	var cgroupCPUs float64
+	if cg := self.cgroupLimits(); cg != nil && cg.CPUDir != "" {
+		if usage, err := self.readCgroupCPUUsage(cg.CPUDir); err == nil {
+			cgroupDir = cg.CPUDir
+			cgroupUsage = usage
+			cgroupCPUs = cg.cpuCount(cpuInfo.Count)
+			cpuInfo.Scope = string(ScopeCgroup)
+			cpuInfo.Cgroup = cg.Path
+			cpuInfo.CPULimit = cgroupCPUs
+		}
+	}
+
 	currentTime := now.UnixMilli()
 
 	var cursorData models.CPUCursorData
@@ -105,6 +128,22 @@ func (self *GopsUtil) GetCPUInfoWithCursor(cursor string) (*models.CPUInfo, erro
 		if timeDiff > 0 {
 			totalUsage, coreUsages := cpuUsageFromProvider(self.cpuProvider, cursorData.Total, cpuInfo.Total, timeDiff, cpuInfo.Count)
 			cpuInfo.Usage = totalUsage
+			if cgroupCPUs > 0 && cursorData.CgroupUsageUsec > 0 {
+				cpuInfo.Usage = cgroupCPUPercent(cursorData.CgroupUsageUsec, cgroupUsage, timeDiff, cgroupCPUs)
+			}
+			cpuInfo.Breakdown = calculateCPUTimeBreakdown(cursorData.Total, cpuInfo.Total)
+			coreThrottled := applyThrottleDeltas(cpuInfo.CoreThrottle, cursorData.CoreThrottle)
+			packageThrottled := applyThrottleDeltas(cpuInfo.PackageThrottle, cursorData.PackageThrottle)
+			cpuInfo.Throttled = coreThrottled || packageThrottled
+
+			if len(cursorData.Cores) > 0 && len(cursorData.Cores) == len(cpuInfo.Cores) {
+				cpuInfo.CoreBreakdown = make([]models.CPUTimeBreakdown, len(cpuInfo.Cores))
+				for i := range cpuInfo.Cores {
+					if b := calculateCPUTimeBreakdown(cursorData.Cores[i], cpuInfo.Cores[i]); b != nil {
+						cpuInfo.CoreBreakdown[i] = *b
+					}
+				}
+			}
 
 			switch {
 			case coreUsages != nil:
@@ -128,12 +167,22 @@ func (self *GopsUtil) GetCPUInfoWithCursor(cursor string) (*models.CPUInfo, erro
 		if err == nil {
 			cpuInfo.CoreUsage = corePercent
 		}
+
+		// The host sampling above already waited, so reuse that interval
+		if cgroupCPUs > 0 {
+			if usage, err := self.readCgroupCPUUsage(cgroupDir); err == nil {
+				cpuInfo.Usage = cgroupCPUPercent(cgroupUsage, usage, time.Since(now).Seconds(), cgroupCPUs)
+			}
+		}
 	}
 
 	newCursor := models.CPUCursorData{
-		Total:     cpuInfo.Total,
-		Cores:     cpuInfo.Cores,
-		Timestamp: currentTime,
+		Total:           cpuInfo.Total,
+		Cores:           cpuInfo.Cores,
+		CoreThrottle:    stripThrottleDeltas(cpuInfo.CoreThrottle),
+		PackageThrottle: stripThrottleDeltas(cpuInfo.PackageThrottle),
+		CgroupUsageUsec: cgroupUsage,
+		Timestamp:       currentTime,
 	}
 	cursorBytes, _ := json.Marshal(newCursor)
 	cpuInfo.Cursor = base64.RawURLEncoding.EncodeToString(cursorBytes)
@@ -178,3 +227,73 @@ func calculateCPUPercentage(prev, curr []float64) float64 {
 
 	return usage
 }
+
+// applyThrottleDeltas fills in the throttle events and time since the previous
+// cursor sample and reports whether any of them throttled in that interval.
+func applyThrottleDeltas(curr, prev []models.CPUThrottle) bool {
+	prevByID := make(map[int]models.CPUThrottle, len(prev))
+	for _, p := range prev {
+		prevByID[p.ID] = p
+	}
+
+	throttled := false
+	for i := range curr {
+		p, ok := prevByID[curr[i].ID]
+		if !ok || curr[i].Count < p.Count || curr[i].TimeMs < p.TimeMs {
+			continue
+		}
+		curr[i].IntervalCount = curr[i].Count - p.Count
+		curr[i].IntervalTimeMs = curr[i].TimeMs - p.TimeMs
+		if curr[i].IntervalCount > 0 || curr[i].IntervalTimeMs > 0 {
+			throttled = true
+		}
+	}
+	return throttled
+}
+
+func stripThrottleDeltas(throttle []models.CPUThrottle) []models.CPUThrottle {
+	if len(throttle) == 0 {
+		return nil
+	}
+	stripped := make([]models.CPUThrottle, len(throttle))
+	for i, t := range throttle {
+		stripped[i] = models.CPUThrottle{ID: t.ID, Count: t.Count, TimeMs: t.TimeMs}
+	}
+	return stripped
+}
+
+// calculateCPUTimeBreakdown splits the jiffies delta between two cursor samples
+// into per-state percentages of the elapsed CPU time.
+func calculateCPUTimeBreakdown(prev, curr []float64) *models.CPUTimeBreakdown {
+	if len(prev) < 8 || len(curr) < 8 {
+		return nil
+	}
+
+	var delta [8]float64
+	var total float64
+	for i := 0; i < 8; i++ {
+		d := curr[i] - prev[i]
+		if d < 0 {
+			d = 0
+		}
+		delta[i] = d
+		total += d
+	}
+
+	if total <= 0 {
+		return nil
+	}
+
+	pct := func(v float64) float64 { return v / total * 100.0 }
+
+	return &models.CPUTimeBreakdown{
+		User:    pct(delta[0]),
+		Nice:    pct(delta[1]),
+		System:  pct(delta[2]),
+		Idle:    pct(delta[3]),
+		Iowait:  pct(delta[4]),
+		Irq:     pct(delta[5]),
+		Softirq: pct(delta[6]),
+		Steal:   pct(delta[7]),
+	}
+}
@@ -5,6 +5,7 @@ package gops
 import (
 	"time"
 
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/shirou/gopsutil/v4/cpu"
 )
 
@@ -16,6 +17,14 @@ func getCurrentCPUFreq() float64 {
 	return 0
 }
 
+func (self *GopsUtil) getCoreFrequencies() ([]models.CPUCoreFreq, string) {
+	return nil, ""
+}
+
+func (self *GopsUtil) getThrottleCounters() ([]models.CPUThrottle, []models.CPUThrottle) {
+	return nil, nil
+}
+
 // cpuUsageFromProvider uses gopsutil's cpu.Percent on macOS.
 // The custom tick-ratio calculation is unreliable on Apple Silicon because
 // host_processor_info may not account for parked efficiency cores correctly,
@@ -1,6 +1,7 @@
 package gops
 
 import (
+	"io/fs"
 	"testing"
 	"time"
 
@@ -11,6 +12,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// expectNoSysfs makes every sysfs read fail, as on a machine without cpufreq
+func expectNoSysfs(mockFS *mocks.MockFileSystem) {
+	mockFS.EXPECT().ReadDir(mock.Anything).Return(nil, fs.ErrNotExist).Maybe()
+	mockFS.EXPECT().ReadFile(mock.Anything).Return(nil, fs.ErrNotExist).Maybe()
+	mockFS.EXPECT().Stat(mock.Anything).Return(nil, fs.ErrNotExist).Maybe()
+}
+
 func TestGetCPUInfo_WithMocks(t *testing.T) {
 	mockCPU := mocks.NewMockCPUInfoProvider(t)
 	mockMem := mocks.NewMockMemoryInfoProvider(t)
@@ -20,6 +28,7 @@ func TestGetCPUInfo_WithMocks(t *testing.T) {
 	mockHost := mocks.NewMockHostInfoProvider(t)
 	mockLoad := mocks.NewMockLoadInfoProvider(t)
 	mockFS := mocks.NewMockFileSystem(t)
+	expectNoSysfs(mockFS)
 
 	gops := NewGopsUtilWithProviders(
 		mockCPU,
@@ -30,6 +39,7 @@ func TestGetCPUInfo_WithMocks(t *testing.T) {
 		mockHost,
 		mockLoad,
 		mockFS,
+		nil,
 	)
 
 	cpuTracker.modelCached = false
@@ -112,6 +122,7 @@ func TestGetCPUInfoWithCursor_WithMocks(t *testing.T) {
 	mockHost := mocks.NewMockHostInfoProvider(t)
 	mockLoad := mocks.NewMockLoadInfoProvider(t)
 	mockFS := mocks.NewMockFileSystem(t)
+	expectNoSysfs(mockFS)
 
 	gops := NewGopsUtilWithProviders(
 		mockCPU,
@@ -122,6 +133,7 @@ func TestGetCPUInfoWithCursor_WithMocks(t *testing.T) {
 		mockHost,
 		mockLoad,
 		mockFS,
+		nil,
 	)
 
 	mockCPU.EXPECT().
@@ -203,6 +215,7 @@ func TestGetCPUInfo_ErrorHandling(t *testing.T) {
 			mockHost := mocks.NewMockHostInfoProvider(t)
 			mockLoad := mocks.NewMockLoadInfoProvider(t)
 			mockFS := mocks.NewMockFileSystem(t)
+			expectNoSysfs(mockFS)
 
 			gops := NewGopsUtilWithProviders(
 				mockCPU,
@@ -213,6 +226,7 @@ func TestGetCPUInfo_ErrorHandling(t *testing.T) {
 				mockHost,
 				mockLoad,
 				mockFS,
+				nil,
 			)
 
 			tt.setupMocks(mockCPU)
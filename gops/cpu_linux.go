@@ -5,10 +5,15 @@ package gops
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
 )
 
+const cpuSysfsPath = "/sys/devices/system/cpu"
+
 func getCPUTemperatureCached() float64 {
 	if cpuTracker.tempPath != "" {
 		tempBytes, err := os.ReadFile(cpuTracker.tempPath)
@@ -105,6 +110,138 @@ func getCurrentCPUFreq() float64 {
 	return 0
 }
 
+func (self *GopsUtil) getCoreFrequencies() ([]models.CPUCoreFreq, string) {
+	return self.readCoreFrequencies(cpuSysfsPath)
+}
+
+// readCoreFrequencies walks cpu*/cpufreq under cpuRoot. sysfs reports kHz; the
+// result is converted to MHz to match CPUInfo.Frequency.
+func (self *GopsUtil) readCoreFrequencies(cpuRoot string) ([]models.CPUCoreFreq, string) {
+	entries, err := self.fs.ReadDir(cpuRoot)
+	if err != nil {
+		return nil, ""
+	}
+
+	var freqs []models.CPUCoreFreq
+	var driver string
+	for _, entry := range entries {
+		core, ok := parseCPUDirIndex(entry.Name())
+		if !ok {
+			continue
+		}
+
+		freqDir := filepath.Join(cpuRoot, entry.Name(), "cpufreq")
+		cur, err := self.readSysfsUint(filepath.Join(freqDir, "scaling_cur_freq"))
+		if err != nil {
+			continue
+		}
+
+		coreFreq := models.CPUCoreFreq{
+			Core:     core,
+			Current:  float64(cur) / 1000.0,
+			Governor: self.readSysfsString(filepath.Join(freqDir, "scaling_governor")),
+			EPP:      self.readSysfsString(filepath.Join(freqDir, "energy_performance_preference")),
+		}
+		if v, err := self.readSysfsUint(filepath.Join(freqDir, "scaling_min_freq")); err == nil {
+			coreFreq.Min = float64(v) / 1000.0
+		}
+		if v, err := self.readSysfsUint(filepath.Join(freqDir, "scaling_max_freq")); err == nil {
+			coreFreq.Max = float64(v) / 1000.0
+		}
+		if v, err := self.readSysfsUint(filepath.Join(freqDir, "cpuinfo_min_freq")); err == nil {
+			coreFreq.HWMin = float64(v) / 1000.0
+		}
+		if v, err := self.readSysfsUint(filepath.Join(freqDir, "cpuinfo_max_freq")); err == nil {
+			coreFreq.HWMax = float64(v) / 1000.0
+		}
+		if driver == "" {
+			driver = self.readSysfsString(filepath.Join(freqDir, "scaling_driver"))
+		}
+
+		freqs = append(freqs, coreFreq)
+	}
+
+	sort.Slice(freqs, func(i, j int) bool {
+		return freqs[i].Core < freqs[j].Core
+	})
+
+	return freqs, driver
+}
+
+// getThrottleCounters returns the per-core and per-package thermal throttle
+// counters, or nothing on CPUs without thermal_throttle in sysfs.
+func (self *GopsUtil) getThrottleCounters() ([]models.CPUThrottle, []models.CPUThrottle) {
+	return self.readThrottleCounters(cpuSysfsPath)
+}
+
+// readThrottleCounters reads the x86 thermal_throttle counters. Core counters
+// are keyed by logical CPU, package counters by physical package id (every CPU
+// in a package reports the same package counters).
+func (self *GopsUtil) readThrottleCounters(cpuRoot string) ([]models.CPUThrottle, []models.CPUThrottle) {
+	entries, err := self.fs.ReadDir(cpuRoot)
+	if err != nil {
+		return nil, nil
+	}
+
+	var cores, packages []models.CPUThrottle
+	seenPackages := make(map[int]bool)
+
+	for _, entry := range entries {
+		idx, ok := parseCPUDirIndex(entry.Name())
+		if !ok {
+			continue
+		}
+		dir := filepath.Join(cpuRoot, entry.Name(), "thermal_throttle")
+
+		if count, err := self.readSysfsUint(filepath.Join(dir, "core_throttle_count")); err == nil {
+			timeMs, _ := self.readSysfsUint(filepath.Join(dir, "core_throttle_total_time_ms"))
+			cores = append(cores, models.CPUThrottle{ID: idx, Count: count, TimeMs: timeMs})
+		}
+
+		pkg, err := self.readSysfsUint(filepath.Join(cpuRoot, entry.Name(), "topology", "physical_package_id"))
+		if err != nil || seenPackages[int(pkg)] {
+			continue
+		}
+		if count, err := self.readSysfsUint(filepath.Join(dir, "package_throttle_count")); err == nil {
+			timeMs, _ := self.readSysfsUint(filepath.Join(dir, "package_throttle_total_time_ms"))
+			packages = append(packages, models.CPUThrottle{ID: int(pkg), Count: count, TimeMs: timeMs})
+			seenPackages[int(pkg)] = true
+		}
+	}
+
+	sort.Slice(cores, func(i, j int) bool { return cores[i].ID < cores[j].ID })
+	sort.Slice(packages, func(i, j int) bool { return packages[i].ID < packages[j].ID })
+	return cores, packages
+}
+
+// parseCPUDirIndex returns N for a "cpuN" sysfs entry, rejecting cpufreq, cpuidle, etc.
+func parseCPUDirIndex(name string) (int, bool) {
+	if !strings.HasPrefix(name, "cpu") {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(strings.TrimPrefix(name, "cpu"))
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+	return idx, true
+}
+
+func (self *GopsUtil) readSysfsString(path string) string {
+	data, err := self.fs.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func (self *GopsUtil) readSysfsUint(path string) (uint64, error) {
+	data, err := self.fs.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
 func getMaxACPITZTemperature(thermalPath string, thermalEntries []os.DirEntry, minTemp, maxTemp float64, isCPU bool) float64 {
 	var highestTemp float64
 
@@ -6,7 +6,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestReadThermalTemp(t *testing.T) {
@@ -23,3 +25,101 @@ func TestGetMaxACPITZTemperature(t *testing.T) {
 	result := getMaxACPITZTemperature("/nonexistent", []os.DirEntry{}, 20, 100, true)
 	assert.Equal(t, float64(0), result, "Should return 0 for empty entries")
 }
+
+func TestReadCoreFrequencies(t *testing.T) {
+	gops, fsys := newFixtureGops(map[string]string{
+		"/sys/devices/system/cpu/cpu0/cpufreq/scaling_cur_freq":              "3200000",
+		"/sys/devices/system/cpu/cpu0/cpufreq/scaling_min_freq":              "400000",
+		"/sys/devices/system/cpu/cpu0/cpufreq/scaling_max_freq":              "4800000",
+		"/sys/devices/system/cpu/cpu0/cpufreq/cpuinfo_min_freq":              "400000",
+		"/sys/devices/system/cpu/cpu0/cpufreq/cpuinfo_max_freq":              "5000000",
+		"/sys/devices/system/cpu/cpu0/cpufreq/scaling_governor":              "powersave",
+		"/sys/devices/system/cpu/cpu0/cpufreq/energy_performance_preference": "balance_performance",
+		"/sys/devices/system/cpu/cpu0/cpufreq/scaling_driver":                "intel_pstate",
+		"/sys/devices/system/cpu/cpu10/cpufreq/scaling_cur_freq":             "1100000",
+		"/sys/devices/system/cpu/cpu10/cpufreq/scaling_governor":             "performance",
+		"/sys/devices/system/cpu/cpu2/cpufreq/scaling_cur_freq":              "2400000",
+		"/sys/devices/system/cpu/cpufreq/boost":                              "1",
+	})
+	fsys.mkdir("/sys/devices/system/cpu/cpu3") // offline, no cpufreq
+
+	freqs, driver := gops.readCoreFrequencies(cpuSysfsPath)
+
+	assert.Equal(t, "intel_pstate", driver)
+	require.Len(t, freqs, 3)
+	assert.Equal(t, []int{0, 2, 10}, []int{freqs[0].Core, freqs[1].Core, freqs[2].Core})
+
+	assert.InDelta(t, 3200.0, freqs[0].Current, 0.001)
+	assert.InDelta(t, 400.0, freqs[0].Min, 0.001)
+	assert.InDelta(t, 4800.0, freqs[0].Max, 0.001)
+	assert.InDelta(t, 5000.0, freqs[0].HWMax, 0.001)
+	assert.Equal(t, "powersave", freqs[0].Governor)
+	assert.Equal(t, "balance_performance", freqs[0].EPP)
+
+	assert.Equal(t, "performance", freqs[2].Governor)
+	assert.Empty(t, freqs[1].EPP)
+}
+
+func TestReadCoreFrequenciesMissingRoot(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	freqs, driver := gops.readCoreFrequencies(cpuSysfsPath)
+	assert.Nil(t, freqs)
+	assert.Empty(t, driver)
+}
+
+func TestParseCPUDirIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry string
+		idx   int
+		ok    bool
+	}{
+		{"cpu0", "cpu0", 0, true},
+		{"cpu127", "cpu127", 127, true},
+		{"cpufreq", "cpufreq", 0, false},
+		{"cpuidle", "cpuidle", 0, false},
+		{"bare cpu", "cpu", 0, false},
+		{"online", "online", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx, ok := parseCPUDirIndex(tt.entry)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.idx, idx)
+		})
+	}
+}
+
+func TestReadThrottleCounters(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/devices/system/cpu/cpu0/topology/physical_package_id":                    "0",
+		"/sys/devices/system/cpu/cpu0/thermal_throttle/core_throttle_count":            "4",
+		"/sys/devices/system/cpu/cpu0/thermal_throttle/core_throttle_total_time_ms":    "120",
+		"/sys/devices/system/cpu/cpu0/thermal_throttle/package_throttle_count":         "9",
+		"/sys/devices/system/cpu/cpu0/thermal_throttle/package_throttle_total_time_ms": "300",
+		"/sys/devices/system/cpu/cpu1/topology/physical_package_id":                    "0",
+		"/sys/devices/system/cpu/cpu1/thermal_throttle/core_throttle_count":            "0",
+		"/sys/devices/system/cpu/cpu1/thermal_throttle/core_throttle_total_time_ms":    "0",
+		"/sys/devices/system/cpu/cpu1/thermal_throttle/package_throttle_count":         "9",
+		"/sys/devices/system/cpu/cpu1/thermal_throttle/package_throttle_total_time_ms": "300",
+		"/sys/devices/system/cpu/cpu2/topology/physical_package_id":                    "1",
+		"/sys/devices/system/cpu/cpu2/thermal_throttle/package_throttle_count":         "1",
+	})
+
+	cores, packages := gops.readThrottleCounters(cpuSysfsPath)
+	require.Len(t, cores, 2)
+	assert.Equal(t, models.CPUThrottle{ID: 0, Count: 4, TimeMs: 120}, cores[0])
+	assert.Equal(t, 1, cores[1].ID)
+
+	require.Len(t, packages, 2)
+	assert.Equal(t, models.CPUThrottle{ID: 0, Count: 9, TimeMs: 300}, packages[0])
+	assert.Equal(t, models.CPUThrottle{ID: 1, Count: 1}, packages[1])
+}
+
+func TestReadThrottleCountersMissing(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	cores, packages := gops.readThrottleCounters(cpuSysfsPath)
+	assert.Nil(t, cores)
+	assert.Nil(t, packages)
+}
@@ -3,7 +3,9 @@ package gops
 import (
 	"testing"
 
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCalculateCPUPercentage(t *testing.T) {
@@ -122,6 +124,45 @@ func TestCalculateCPUPercentageRealWorld(t *testing.T) {
 	}
 }
 
+func TestCalculateCPUTimeBreakdown(t *testing.T) {
+	prev := []float64{1000, 100, 500, 8000, 200, 50, 50, 100}
+	curr := []float64{1300, 100, 600, 8400, 300, 60, 90, 150}
+
+	b := calculateCPUTimeBreakdown(prev, curr)
+	require.NotNil(t, b)
+
+	// delta total = 300+0+100+400+100+10+40+50 = 1000
+	assert.InDelta(t, 30.0, b.User, 0.001)
+	assert.InDelta(t, 0.0, b.Nice, 0.001)
+	assert.InDelta(t, 10.0, b.System, 0.001)
+	assert.InDelta(t, 40.0, b.Idle, 0.001)
+	assert.InDelta(t, 10.0, b.Iowait, 0.001)
+	assert.InDelta(t, 1.0, b.Irq, 0.001)
+	assert.InDelta(t, 4.0, b.Softirq, 0.001)
+	assert.InDelta(t, 5.0, b.Steal, 0.001)
+
+	sum := b.User + b.Nice + b.System + b.Idle + b.Iowait + b.Irq + b.Softirq + b.Steal
+	assert.InDelta(t, 100.0, sum, 0.001)
+}
+
+func TestCalculateCPUTimeBreakdownInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		prev []float64
+		curr []float64
+	}{
+		{"too few values", []float64{1, 2, 3}, []float64{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"no elapsed time", []float64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"counters went backwards", []float64{10, 10, 10, 10, 10, 10, 10, 10}, []float64{1, 1, 1, 1, 1, 1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Nil(t, calculateCPUTimeBreakdown(tt.prev, tt.curr))
+		})
+	}
+}
+
 func BenchmarkCalculateCPUPercentage(b *testing.B) {
 	prev := []float64{1000, 0, 500, 8500, 0, 0, 0, 0}
 	curr := []float64{2000, 0, 1000, 17000, 0, 0, 0, 0}
@@ -131,3 +172,37 @@ func BenchmarkCalculateCPUPercentage(b *testing.B) {
 		calculateCPUPercentage(prev, curr)
 	}
 }
+
+func TestApplyThrottleDeltas(t *testing.T) {
+	prev := []models.CPUThrottle{
+		{ID: 0, Count: 10, TimeMs: 100},
+		{ID: 1, Count: 5, TimeMs: 50},
+	}
+	curr := []models.CPUThrottle{
+		{ID: 0, Count: 13, TimeMs: 160},
+		{ID: 1, Count: 5, TimeMs: 50},
+		{ID: 2, Count: 7, TimeMs: 70},
+	}
+
+	assert.True(t, applyThrottleDeltas(curr, prev))
+	assert.Equal(t, uint64(3), curr[0].IntervalCount)
+	assert.Equal(t, uint64(60), curr[0].IntervalTimeMs)
+	assert.Equal(t, uint64(0), curr[1].IntervalCount)
+	// New entries without a previous sample have no interval
+	assert.Equal(t, uint64(0), curr[2].IntervalCount)
+
+	stripped := stripThrottleDeltas(curr)
+	assert.Equal(t, uint64(0), stripped[0].IntervalCount)
+	assert.Equal(t, uint64(13), stripped[0].Count)
+}
+
+func TestApplyThrottleDeltasIdle(t *testing.T) {
+	prev := []models.CPUThrottle{{ID: 0, Count: 10, TimeMs: 100}}
+	curr := []models.CPUThrottle{{ID: 0, Count: 10, TimeMs: 100}}
+	assert.False(t, applyThrottleDeltas(curr, prev))
+
+	// Counter reset (e.g. module reload) is not reported as throttling
+	reset := []models.CPUThrottle{{ID: 0, Count: 1, TimeMs: 5}}
+	assert.False(t, applyThrottleDeltas(reset, prev))
+	assert.Nil(t, stripThrottleDeltas(nil))
+}
@@ -1,7 +1,11 @@
 package gops
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/AvengeMedia/dgop/models"
 )
@@ -24,12 +28,51 @@ func (self *GopsUtil) GetDiskInfo() ([]*models.DiskInfo, error) {
 	return res, nil
 }
 
+// Growth rates are averaged over up to this much cursor history so a burst
+// of writes does not turn into a "full in 30s" warning
+const mountGrowthWindow = 10 * time.Minute
+
+type DiskMountsCursor struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Mounts    map[string]mountSample `json:"mounts"`
+}
+
+type mountSample struct {
+	Used       uint64  `json:"used"`
+	InodesUsed uint64  `json:"inodesUsed"`
+	Rate       float64 `json:"rate"`
+	InodeRate  float64 `json:"inodeRate"`
+	History    float64 `json:"history"`
+}
+
 func (self *GopsUtil) GetDiskMounts() ([]*models.DiskMountInfo, error) {
+	resp, err := self.GetDiskMountsWithCursor("")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Mounts, nil
+}
+
+func (self *GopsUtil) GetDiskMountsWithCursor(cursorStr string) (*models.DiskMountsResponse, error) {
 	partitions, err := self.diskProvider.Partitions(true)
 	if err != nil {
 		return nil, err
 	}
 
+	var cursor DiskMountsCursor
+	if cursorStr != "" {
+		cursor, _ = parseDiskMountsCursor(cursorStr)
+	}
+	currentTime := time.Now()
+	timeDiff := currentTime.Sub(cursor.Timestamp).Seconds()
+
+	next := DiskMountsCursor{
+		Timestamp: currentTime,
+		Mounts:    make(map[string]mountSample),
+	}
+
+	devices, _ := self.readBlockDevices()
+
 	var metrics []*models.DiskMountInfo
 	for _, p := range partitions {
 		if isVirtualFS(p.Fstype) || isVirtualMount(p.Mountpoint) {
@@ -41,18 +84,94 @@ func (self *GopsUtil) GetDiskMounts() ([]*models.DiskMountInfo, error) {
 			continue
 		}
 
-		metrics = append(metrics, &models.DiskMountInfo{
-			Device:  p.Device,
-			Mount:   p.Mountpoint,
-			FSType:  p.Fstype,
-			Size:    formatBytes(usage.Total),
-			Used:    formatBytes(usage.Used),
-			Avail:   formatBytes(usage.Free),
-			Percent: fmt.Sprintf("%.0f%%", usage.UsedPercent),
-		})
+		info := &models.DiskMountInfo{
+			Device:            p.Device,
+			Mount:             p.Mountpoint,
+			FSType:            p.Fstype,
+			Size:              formatBytes(usage.Total),
+			Used:              formatBytes(usage.Used),
+			Avail:             formatBytes(usage.Free),
+			Percent:           fmt.Sprintf("%.0f%%", usage.UsedPercent),
+			Disks:             physicalDisks(devices, self.findBlockDevice(devices, p.Device)),
+			TotalBytes:        usage.Total,
+			UsedBytes:         usage.Used,
+			AvailBytes:        usage.Free,
+			UsedPercent:       usage.UsedPercent,
+			InodesTotal:       usage.InodesTotal,
+			InodesUsed:        usage.InodesUsed,
+			InodesFree:        usage.InodesFree,
+			InodesUsedPercent: usage.InodesUsedPercent,
+		}
+
+		sample := mountSample{Used: usage.Used, InodesUsed: usage.InodesUsed}
+		if prev, ok := cursor.Mounts[p.Mountpoint]; ok && timeDiff > 0 {
+			sample = applyMountGrowth(info, prev, timeDiff)
+		}
+		next.Mounts[p.Mountpoint] = sample
+
+		metrics = append(metrics, info)
+	}
+
+	cursorStr, err = encodeDiskMountsCursor(next)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DiskMountsResponse{
+		Mounts: metrics,
+		Cursor: cursorStr,
+	}, nil
+}
+
+// applyMountGrowth folds the change since prev into the smoothed byte and
+// inode growth rates and derives how long until space or inodes run out
+func applyMountGrowth(info *models.DiskMountInfo, prev mountSample, timeDiff float64) mountSample {
+	history := math.Min(prev.History, mountGrowthWindow.Seconds())
+	weight := timeDiff / (history + timeDiff)
+
+	rate := (float64(info.UsedBytes) - float64(prev.Used)) / timeDiff
+	inodeRate := (float64(info.InodesUsed) - float64(prev.InodesUsed)) / timeDiff
+
+	info.GrowthRate = prev.Rate + weight*(rate-prev.Rate)
+	info.InodeGrowthRate = prev.InodeRate + weight*(inodeRate-prev.InodeRate)
+	info.FullIn = timeUntilFull(info.AvailBytes, info.GrowthRate)
+	info.InodesFullIn = timeUntilFull(info.InodesFree, info.InodeGrowthRate)
+
+	return mountSample{
+		Used:       info.UsedBytes,
+		InodesUsed: info.InodesUsed,
+		Rate:       info.GrowthRate,
+		InodeRate:  info.InodeGrowthRate,
+		History:    prev.History + timeDiff,
+	}
+}
+
+// timeUntilFull returns seconds until free reaches zero, or 0 when not growing
+func timeUntilFull(free uint64, rate float64) float64 {
+	if rate <= 0 {
+		return 0
+	}
+	return float64(free) / rate
+}
+
+func encodeDiskMountsCursor(cursor DiskMountsCursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parseDiskMountsCursor(cursorStr string) (DiskMountsCursor, error) {
+	var cursor DiskMountsCursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
 	}
 
-	return metrics, nil
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
 }
 
 func formatBytes(bytes uint64) string {
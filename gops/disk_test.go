@@ -2,7 +2,9 @@ package gops
 
 import (
 	"testing"
+	"time"
 
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,3 +58,65 @@ func TestFormatBytesRounding(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyMountGrowth(t *testing.T) {
+	info := &models.DiskMountInfo{
+		UsedBytes:  2000,
+		AvailBytes: 36000,
+		InodesUsed: 100,
+		InodesFree: 900,
+	}
+	prev := mountSample{Used: 1000, InodesUsed: 100}
+
+	next := applyMountGrowth(info, prev, 10)
+
+	assert.InDelta(t, 100.0, info.GrowthRate, 0.001)
+	assert.InDelta(t, 360.0, info.FullIn, 0.001)
+	assert.Zero(t, info.InodeGrowthRate)
+	assert.Zero(t, info.InodesFullIn)
+	assert.Equal(t, uint64(2000), next.Used)
+	assert.InDelta(t, 10.0, next.History, 0.001)
+}
+
+func TestApplyMountGrowthSmoothing(t *testing.T) {
+	// A burst after a long quiet history only moves the rate part of the way
+	info := &models.DiskMountInfo{UsedBytes: 11000, AvailBytes: 1000000}
+	prev := mountSample{Used: 1000, Rate: 0, History: 3600}
+
+	applyMountGrowth(info, prev, 10)
+
+	burst := 1000.0
+	weight := 10 / (mountGrowthWindow.Seconds() + 10)
+	assert.InDelta(t, burst*weight, info.GrowthRate, 0.001)
+	assert.Greater(t, info.FullIn, 0.0)
+}
+
+func TestApplyMountGrowthShrinking(t *testing.T) {
+	info := &models.DiskMountInfo{UsedBytes: 500, AvailBytes: 1000}
+	prev := mountSample{Used: 1000}
+
+	applyMountGrowth(info, prev, 5)
+
+	assert.InDelta(t, -100.0, info.GrowthRate, 0.001)
+	assert.Zero(t, info.FullIn)
+}
+
+func TestDiskMountsCursorRoundTrip(t *testing.T) {
+	cursor := DiskMountsCursor{
+		Timestamp: time.Unix(1700000000, 0).UTC(),
+		Mounts: map[string]mountSample{
+			"/var": {Used: 1234, InodesUsed: 56, Rate: 7.5, History: 120},
+		},
+	}
+
+	encoded, err := encodeDiskMountsCursor(cursor)
+	assert.NoError(t, err)
+
+	decoded, err := parseDiskMountsCursor(encoded)
+	assert.NoError(t, err)
+	assert.True(t, cursor.Timestamp.Equal(decoded.Timestamp))
+	assert.Equal(t, cursor.Mounts, decoded.Mounts)
+
+	_, err = parseDiskMountsCursor("not base64!")
+	assert.Error(t, err)
+}
@@ -3,6 +3,8 @@ package gops
 import (
 	"encoding/base64"
 	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AvengeMedia/dgop/models"
@@ -12,6 +14,17 @@ import (
 type DiskRateCursor struct {
 	Timestamp time.Time                      `json:"timestamp"`
 	IOStats   map[string]disk.IOCountersStat `json:"iostats"`
+	Extended  map[string]DiskExtendedStats   `json:"extended,omitempty"`
+}
+
+// DiskExtendedStats holds the discard and flush counters from /proc/diskstats
+// that gopsutil does not expose. Discards need 4.18+, flushes 5.5+.
+type DiskExtendedStats struct {
+	DiscardCount   uint64 `json:"discardCount"`
+	DiscardSectors uint64 `json:"discardSectors"`
+	DiscardTime    uint64 `json:"discardTime"`
+	FlushCount     uint64 `json:"flushCount"`
+	FlushTime      uint64 `json:"flushTime"`
 }
 
 func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse, error) {
@@ -26,6 +39,8 @@ func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse,
 		currentStats[name] = stats
 	}
 
+	currentExtended, _ := self.readDiskExtendedStats()
+
 	currentTime := time.Now()
 	disks := make([]*models.DiskRateInfo, 0)
 
@@ -40,7 +55,7 @@ func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse,
 						readRate := float64(current.ReadBytes-prev.ReadBytes) / timeDiff
 						writeRate := float64(current.WriteBytes-prev.WriteBytes) / timeDiff
 
-						disks = append(disks, &models.DiskRateInfo{
+						info := &models.DiskRateInfo{
 							Device:     name,
 							ReadRate:   readRate,
 							WriteRate:  writeRate,
@@ -48,7 +63,15 @@ func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse,
 							WriteTotal: current.WriteBytes,
 							ReadCount:  current.ReadCount,
 							WriteCount: current.WriteCount,
-						})
+							InFlight:   current.IopsInProgress,
+						}
+						applyDiskIOStats(info, current, prev, timeDiff)
+						if currExt, ok := currentExtended[name]; ok {
+							if prevExt, ok := cursor.Extended[name]; ok {
+								applyDiskExtendedStats(info, currExt, prevExt, timeDiff)
+							}
+						}
+						disks = append(disks, info)
 					}
 				}
 			}
@@ -66,6 +89,7 @@ func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse,
 				WriteTotal: current.WriteBytes,
 				ReadCount:  current.ReadCount,
 				WriteCount: current.WriteCount,
+				InFlight:   current.IopsInProgress,
 			})
 		}
 	}
@@ -74,6 +98,7 @@ func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse,
 	newCursor := DiskRateCursor{
 		Timestamp: currentTime,
 		IOStats:   currentStats,
+		Extended:  currentExtended,
 	}
 
 	newCursorStr, err := encodeDiskRateCursor(newCursor)
@@ -87,6 +112,87 @@ func (self *GopsUtil) GetDiskRates(cursorStr string) (*models.DiskRateResponse,
 	}, nil
 }
 
+// applyDiskIOStats derives the iostat -x style figures from two samples:
+// per-second op counts, average wait per op in ms, average request size,
+// %util from io_ticks and the average queue size from the weighted io time.
+func applyDiskIOStats(info *models.DiskRateInfo, curr, prev disk.IOCountersStat, timeDiff float64) {
+	readOps := counterDelta(curr.ReadCount, prev.ReadCount)
+	writeOps := counterDelta(curr.WriteCount, prev.WriteCount)
+
+	info.ReadIOPS = float64(readOps) / timeDiff
+	info.WriteIOPS = float64(writeOps) / timeDiff
+	info.ReadMerged = counterRate(curr.MergedReadCount, prev.MergedReadCount, timeDiff)
+	info.WriteMerged = counterRate(curr.MergedWriteCount, prev.MergedWriteCount, timeDiff)
+	info.ReadAwait = perOp(counterDelta(curr.ReadTime, prev.ReadTime), readOps)
+	info.WriteAwait = perOp(counterDelta(curr.WriteTime, prev.WriteTime), writeOps)
+	info.ReadReqSize = perOp(counterDelta(curr.ReadBytes, prev.ReadBytes), readOps)
+	info.WriteReqSize = perOp(counterDelta(curr.WriteBytes, prev.WriteBytes), writeOps)
+
+	elapsedMs := timeDiff * 1000
+	info.AvgQueueSize = float64(counterDelta(curr.WeightedIO, prev.WeightedIO)) / elapsedMs
+	info.Util = float64(counterDelta(curr.IoTime, prev.IoTime)) / elapsedMs * 100
+	if info.Util > 100 {
+		info.Util = 100
+	}
+}
+
+func applyDiskExtendedStats(info *models.DiskRateInfo, curr, prev DiskExtendedStats, timeDiff float64) {
+	discardOps := counterDelta(curr.DiscardCount, prev.DiscardCount)
+	flushOps := counterDelta(curr.FlushCount, prev.FlushCount)
+
+	info.DiscardIOPS = float64(discardOps) / timeDiff
+	info.DiscardRate = float64(counterDelta(curr.DiscardSectors, prev.DiscardSectors)*diskSectorSize) / timeDiff
+	info.DiscardAwait = perOp(counterDelta(curr.DiscardTime, prev.DiscardTime), discardOps)
+	info.FlushIOPS = float64(flushOps) / timeDiff
+	info.FlushAwait = perOp(counterDelta(curr.FlushTime, prev.FlushTime), flushOps)
+}
+
+// /proc/diskstats always counts in 512-byte sectors, whatever the device uses
+const diskSectorSize = 512
+
+// parseDiskstatsExtended reads the discard and flush columns of
+// /proc/diskstats, keyed by device name.
+func parseDiskstatsExtended(content string) map[string]DiskExtendedStats {
+	stats := make(map[string]DiskExtendedStats)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 18 {
+			continue
+		}
+
+		values := make([]uint64, len(fields))
+		for i := 14; i < len(fields) && i < 20; i++ {
+			values[i], _ = strconv.ParseUint(fields[i], 10, 64)
+		}
+
+		ext := DiskExtendedStats{
+			DiscardCount:   values[14],
+			DiscardSectors: values[16],
+			DiscardTime:    values[17],
+		}
+		if len(fields) >= 20 {
+			ext.FlushCount = values[18]
+			ext.FlushTime = values[19]
+		}
+		stats[fields[2]] = ext
+	}
+	return stats
+}
+
+func counterDelta(curr, prev uint64) uint64 {
+	if curr < prev {
+		return 0
+	}
+	return curr - prev
+}
+
+func perOp(total, ops uint64) float64 {
+	if ops == 0 {
+		return 0
+	}
+	return float64(total) / float64(ops)
+}
+
 func encodeDiskRateCursor(cursor DiskRateCursor) (string, error) {
 	jsonData, err := json.Marshal(cursor)
 	if err != nil {
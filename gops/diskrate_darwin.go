@@ -0,0 +1,7 @@
+//go:build darwin
+
+package gops
+
+func (self *GopsUtil) readDiskExtendedStats() (map[string]DiskExtendedStats, error) {
+	return nil, nil
+}
@@ -0,0 +1,13 @@
+//go:build linux
+
+package gops
+
+const diskstatsPath = "/proc/diskstats"
+
+func (self *GopsUtil) readDiskExtendedStats() (map[string]DiskExtendedStats, error) {
+	data, err := self.fs.ReadFile(diskstatsPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseDiskstatsExtended(string(data)), nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/AvengeMedia/dgop/models"
 	"github.com/shirou/gopsutil/v4/disk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -220,3 +221,69 @@ func BenchmarkParseDiskRateCursor(b *testing.B) {
 		parseDiskRateCursor(encoded)
 	}
 }
+
+func TestApplyDiskIOStats(t *testing.T) {
+	prev := disk.IOCountersStat{
+		ReadCount: 100, WriteCount: 200, MergedReadCount: 10, MergedWriteCount: 20,
+		ReadBytes: 1 << 20, WriteBytes: 2 << 20, ReadTime: 1000, WriteTime: 3000,
+		IoTime: 5000, WeightedIO: 8000,
+	}
+	curr := disk.IOCountersStat{
+		ReadCount: 300, WriteCount: 250, MergedReadCount: 30, MergedWriteCount: 20,
+		ReadBytes: 1<<20 + 200*4096, WriteBytes: 2<<20 + 50*65536, ReadTime: 1400, WriteTime: 3500,
+		IoTime: 5500, WeightedIO: 9000,
+	}
+
+	info := &models.DiskRateInfo{}
+	applyDiskIOStats(info, curr, prev, 2)
+
+	assert.Equal(t, 100.0, info.ReadIOPS)
+	assert.Equal(t, 25.0, info.WriteIOPS)
+	assert.Equal(t, 10.0, info.ReadMerged)
+	assert.Equal(t, 0.0, info.WriteMerged)
+	assert.Equal(t, 2.0, info.ReadAwait)
+	assert.Equal(t, 10.0, info.WriteAwait)
+	assert.Equal(t, 4096.0, info.ReadReqSize)
+	assert.Equal(t, 65536.0, info.WriteReqSize)
+	assert.Equal(t, 25.0, info.Util)
+	assert.Equal(t, 0.5, info.AvgQueueSize)
+}
+
+func TestApplyDiskIOStatsIdleAndReset(t *testing.T) {
+	prev := disk.IOCountersStat{ReadCount: 500, ReadTime: 900, IoTime: 100}
+	curr := disk.IOCountersStat{ReadCount: 10, ReadTime: 20, IoTime: 5000}
+
+	info := &models.DiskRateInfo{}
+	applyDiskIOStats(info, curr, prev, 1)
+
+	assert.Equal(t, 0.0, info.ReadIOPS)
+	assert.Equal(t, 0.0, info.ReadAwait)
+	assert.Equal(t, 100.0, info.Util)
+}
+
+func TestParseDiskstatsExtended(t *testing.T) {
+	content := `   8       0 sda 100 10 2000 50 200 20 4000 300 0 400 350 7 0 56 9 11 6
+   8       1 sda1 90 10 1800 45 190 20 3900 290 0 380 335 5 0 40 7
+   7       0 loop0 5 0 10 1 0 0 0 0 0 1 1
+`
+	stats := parseDiskstatsExtended(content)
+
+	assert.Equal(t, DiskExtendedStats{DiscardCount: 7, DiscardSectors: 56, DiscardTime: 9, FlushCount: 11, FlushTime: 6}, stats["sda"])
+	assert.Equal(t, DiskExtendedStats{DiscardCount: 5, DiscardSectors: 40, DiscardTime: 7}, stats["sda1"])
+	_, ok := stats["loop0"]
+	assert.False(t, ok)
+}
+
+func TestApplyDiskExtendedStats(t *testing.T) {
+	prev := DiskExtendedStats{DiscardCount: 10, DiscardSectors: 1000, DiscardTime: 50, FlushCount: 4, FlushTime: 8}
+	curr := DiskExtendedStats{DiscardCount: 30, DiscardSectors: 5000, DiscardTime: 90, FlushCount: 8, FlushTime: 20}
+
+	info := &models.DiskRateInfo{}
+	applyDiskExtendedStats(info, curr, prev, 2)
+
+	assert.Equal(t, 10.0, info.DiscardIOPS)
+	assert.Equal(t, 4000.0*512/2, info.DiscardRate)
+	assert.Equal(t, 2.0, info.DiscardAwait)
+	assert.Equal(t, 2.0, info.FlushIOPS)
+	assert.Equal(t, 3.0, info.FlushAwait)
+}
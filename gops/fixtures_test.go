@@ -0,0 +1,61 @@
+package gops
+
+import (
+	"io/fs"
+	"strings"
+	"testing/fstest"
+)
+
+// fixtureFS is an in-memory FileSystem for /proc and /sys fixtures. Names are
+// absolute paths and every file gets the trailing newline the kernel writes.
+type fixtureFS struct {
+	files fstest.MapFS
+}
+
+func newFixtureFS(files map[string]string) *fixtureFS {
+	fsys := &fixtureFS{files: fstest.MapFS{}}
+	for name, content := range files {
+		fsys.files[fixturePath(name)] = &fstest.MapFile{Data: []byte(content + "\n")}
+	}
+	return fsys
+}
+
+// newFixtureGops returns a GopsUtil whose file reads are served from files
+func newFixtureGops(files map[string]string) (*GopsUtil, *fixtureFS) {
+	fsys := newFixtureFS(files)
+	return NewGopsUtilWithProviders(nil, nil, nil, nil, nil, nil, nil, fsys, nil), fsys
+}
+
+func fixturePath(name string) string {
+	name = strings.Trim(name, "/")
+	if name == "" {
+		return "."
+	}
+	return name
+}
+
+func (f *fixtureFS) ReadFile(name string) ([]byte, error) {
+	return f.files.ReadFile(fixturePath(name))
+}
+
+func (f *fixtureFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return f.files.ReadDir(fixturePath(name))
+}
+
+func (f *fixtureFS) Stat(name string) (fs.FileInfo, error) {
+	return f.files.Stat(fixturePath(name))
+}
+
+func (f *fixtureFS) Readlink(name string) (string, error) {
+	return f.files.ReadLink(fixturePath(name))
+}
+
+// symlink adds a link, e.g. /sys/class/block/sda -> ../../devices/.../sda
+func (f *fixtureFS) symlink(name, target string) {
+	f.files[fixturePath(name)] = &fstest.MapFile{Data: []byte(target), Mode: fs.ModeSymlink}
+}
+
+// mkdir adds an empty directory, e.g. an offline cpuN without cpufreq
+func (f *fixtureFS) mkdir(name string) {
+	f.files[fixturePath(name)] = &fstest.MapFile{Mode: fs.ModeDir | 0o755}
+}
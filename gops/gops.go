@@ -1,11 +1,8 @@
 package gops
 
 import (
-	"sync"
-
 	"github.com/AvengeMedia/dgop/internal/log"
 	"github.com/AvengeMedia/dgop/models"
-	"github.com/shirou/gopsutil/v4/sensors"
 )
 
 type GopsUtil struct {
@@ -17,22 +14,29 @@ type GopsUtil struct {
 	hostProvider HostInfoProvider
 	loadProvider LoadInfoProvider
 	fs           FileSystem
-
-	procStaticMu    sync.RWMutex
-	procStaticCache map[int32]processStaticInfo
+	cmd          CommandExecutor
+
+	scope      Scope
+	netFilter  models.NetworkFilter
+	netPolicy  *networkPolicy
+	procNet    bool
+	procStatic *processStaticCache
+	procIO     *processIOCache
 }
 
 func NewGopsUtil() *GopsUtil {
 	return &GopsUtil{
-		cpuProvider:     &DefaultCPUInfoProvider{},
-		memProvider:     &DefaultMemoryInfoProvider{},
-		diskProvider:    &DefaultDiskInfoProvider{},
-		netProvider:     &DefaultNetworkInfoProvider{},
-		procProvider:    &DefaultProcessInfoProvider{},
-		hostProvider:    &DefaultHostInfoProvider{},
-		loadProvider:    &DefaultLoadInfoProvider{},
-		fs:              &DefaultFileSystem{},
-		procStaticCache: make(map[int32]processStaticInfo),
+		cpuProvider:  &DefaultCPUInfoProvider{},
+		memProvider:  &DefaultMemoryInfoProvider{},
+		diskProvider: &DefaultDiskInfoProvider{},
+		netProvider:  &DefaultNetworkInfoProvider{},
+		procProvider: &DefaultProcessInfoProvider{},
+		hostProvider: &DefaultHostInfoProvider{},
+		loadProvider: &DefaultLoadInfoProvider{},
+		fs:           &DefaultFileSystem{},
+		cmd:          &DefaultCommandExecutor{},
+		procStatic:   newProcessStaticCache(),
+		procIO:       newProcessIOCache(),
 	}
 }
 
@@ -46,20 +50,30 @@ func NewGopsUtilWithProviders(
 	host HostInfoProvider,
 	load LoadInfoProvider,
 	fs FileSystem,
+	cmd CommandExecutor,
 ) *GopsUtil {
 	return &GopsUtil{
-		cpuProvider:     cpu,
-		memProvider:     mem,
-		diskProvider:    disk,
-		netProvider:     net,
-		procProvider:    proc,
-		hostProvider:    host,
-		loadProvider:    load,
-		fs:              fs,
-		procStaticCache: make(map[int32]processStaticInfo),
+		cpuProvider:  cpu,
+		memProvider:  mem,
+		diskProvider: disk,
+		netProvider:  net,
+		procProvider: proc,
+		hostProvider: host,
+		loadProvider: load,
+		fs:           fs,
+		cmd:          cmd,
+		procStatic:   newProcessStaticCache(),
+		procIO:       newProcessIOCache(),
 	}
 }
 
+// clone returns a copy sharing providers and caches with self, so a single
+// request can change its own settings without affecting other callers.
+func (self *GopsUtil) clone() *GopsUtil {
+	copied := *self
+	return &copied
+}
+
 func (self *GopsUtil) GetAllMetrics(procSortBy ProcSortBy, procLimit int, enableProcessCPU bool, mergeChildren bool) (*models.SystemMetrics, error) {
 	return self.GetAllMetricsWithCursors(procSortBy, procLimit, enableProcessCPU, "", "", mergeChildren)
 }
@@ -118,7 +132,7 @@ func (self *GopsUtil) GetAllMetricsWithCursors(procSortBy ProcSortBy, procLimit
 
 // GetSystemTemperatures returns system temperature sensors
 func (self *GopsUtil) GetSystemTemperatures() ([]models.TemperatureSensor, error) {
-	temps, err := sensors.SensorsTemperatures()
+	temps, err := self.hostProvider.SensorsTemperatures()
 	if err != nil {
 		return nil, err
 	}
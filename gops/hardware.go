@@ -102,7 +102,7 @@ func (self *GopsUtil) GetGPUTemp(pciId string) (*models.GPUTempInfo, error) {
 
 	switch targetGPU.Driver {
 	case "nvidia":
-		temperature, hwmon = getNvidiaTemperature()
+		temperature, hwmon = getNvidiaTemperature(self.cmd)
 	default:
 		temperature, hwmon = getHwmonTemperature(pciId)
 	}
@@ -5,7 +5,6 @@ package gops
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -184,9 +183,8 @@ func getGPUDriver(bdf string) string {
 	return ""
 }
 
-func getNvidiaTemperature() (float64, string) {
-	cmd := exec.Command("nvidia-smi", "--query-gpu=temperature.gpu", "--format=csv,noheader,nounits")
-	output, err := cmd.Output()
+func getNvidiaTemperature(cmd CommandExecutor) (float64, string) {
+	output, err := cmd.Execute("nvidia-smi", "--query-gpu=temperature.gpu", "--format=csv,noheader,nounits")
 	if err != nil {
 		return 0, "unknown"
 	}
@@ -3,6 +3,7 @@ package gops
 import (
 	"io/fs"
 	"os"
+	"os/exec"
 	"time"
 
 	"github.com/shirou/gopsutil/v4/cpu"
@@ -20,6 +21,7 @@ type FileSystem interface {
 	ReadFile(name string) ([]byte, error)
 	ReadDir(name string) ([]fs.DirEntry, error)
 	Stat(name string) (fs.FileInfo, error)
+	Readlink(name string) (string, error)
 }
 
 // CommandExecutor provides an interface for executing external commands
@@ -87,6 +89,19 @@ func (d *DefaultFileSystem) Stat(name string) (fs.FileInfo, error) {
 	return os.Stat(name)
 }
 
+func (d *DefaultFileSystem) Readlink(name string) (string, error) {
+	return os.Readlink(name)
+}
+
+// DefaultCommandExecutor implements CommandExecutor using os/exec.
+// Stdout is returned alongside exit errors since tools like smartctl
+// report status through the exit code while still printing output
+type DefaultCommandExecutor struct{}
+
+func (d *DefaultCommandExecutor) Execute(name string, args ...string) ([]byte, error) {
+	return exec.Command(name, args...).Output()
+}
+
 // DefaultCPUInfoProvider implements CPUInfoProvider using gopsutil
 type DefaultCPUInfoProvider struct{}
 
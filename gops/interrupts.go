@@ -0,0 +1,206 @@
+package gops
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+type InterruptsCursor struct {
+	Timestamp  time.Time           `json:"timestamp"`
+	Interrupts map[string][]uint64 `json:"interrupts"`
+	Softirqs   map[string][]uint64 `json:"softirqs"`
+}
+
+func (self *GopsUtil) GetInterrupts(cursorStr string) (*models.InterruptsResponse, error) {
+	interruptsContent, softirqsContent, err := self.readInterruptFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	cpus, interrupts, err := parseInterruptTable(interruptsContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse interrupts: %w", err)
+	}
+
+	var softirqs []*models.InterruptInfo
+	if softirqsContent != "" {
+		_, softirqs, err = parseInterruptTable(softirqsContent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse softirqs: %w", err)
+		}
+	}
+
+	currentTime := time.Now()
+
+	// If we have a cursor, calculate per-second rates from the counter deltas
+	if cursorStr != "" {
+		cursor, err := parseInterruptsCursor(cursorStr)
+		if err == nil {
+			timeDiff := currentTime.Sub(cursor.Timestamp).Seconds()
+			if timeDiff > 0 {
+				applyInterruptRates(interrupts, cursor.Interrupts, timeDiff)
+				applyInterruptRates(softirqs, cursor.Softirqs, timeDiff)
+			}
+		}
+	}
+
+	newCursorStr, err := encodeInterruptsCursor(InterruptsCursor{
+		Timestamp:  currentTime,
+		Interrupts: interruptCounters(interrupts),
+		Softirqs:   interruptCounters(softirqs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortInterrupts(interrupts)
+	sortInterrupts(softirqs)
+
+	return &models.InterruptsResponse{
+		CPUs:       cpus,
+		Interrupts: interrupts,
+		Softirqs:   softirqs,
+		Cursor:     newCursorStr,
+	}, nil
+}
+
+var systemWideInterrupts = map[string]bool{"ERR": true, "MIS": true}
+
+// parseInterruptTable parses the /proc/interrupts and /proc/softirqs layout:
+// a header of CPU columns (online CPUs only) followed by one row per source.
+func parseInterruptTable(content string) ([]int, []*models.InterruptInfo, error) {
+	lines := strings.Split(content, "\n")
+	if len(lines) == 0 {
+		return nil, nil, fmt.Errorf("empty interrupt table")
+	}
+
+	var cpus []int
+	for _, field := range strings.Fields(lines[0]) {
+		idx, err := strconv.Atoi(strings.TrimPrefix(field, "CPU"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid cpu column %q", field)
+		}
+		cpus = append(cpus, idx)
+	}
+	if len(cpus) == 0 {
+		return nil, nil, fmt.Errorf("no cpu columns in interrupt table header")
+	}
+
+	var result []*models.InterruptInfo
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.HasSuffix(fields[0], ":") {
+			continue
+		}
+
+		info := &models.InterruptInfo{
+			IRQ: strings.TrimSuffix(fields[0], ":"),
+		}
+
+		var counts []uint64
+		i := 1
+		for ; i < len(fields) && i-1 < len(cpus); i++ {
+			count, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				break
+			}
+			counts = append(counts, count)
+			info.Total += count
+		}
+		if len(counts) == 0 {
+			continue
+		}
+		info.Description = strings.Join(fields[i:], " ")
+
+		// Rows such as ERR and MIS carry a single system-wide count that
+		// belongs to no CPU, so they only get a total
+		if !systemWideInterrupts[info.IRQ] && len(counts) == len(cpus) {
+			info.PerCPU = counts
+		}
+
+		result = append(result, info)
+	}
+
+	return cpus, result, nil
+}
+
+func applyInterruptRates(infos []*models.InterruptInfo, prev map[string][]uint64, timeDiff float64) {
+	for _, info := range infos {
+		prevCounts, ok := prev[info.IRQ]
+		if !ok {
+			continue
+		}
+
+		if info.PerCPU == nil {
+			if len(prevCounts) == 1 {
+				info.PerCPURate = []float64{}
+				info.Rate = counterRate(info.Total, prevCounts[0], timeDiff)
+			}
+			continue
+		}
+		if len(prevCounts) != len(info.PerCPU) {
+			continue
+		}
+
+		info.PerCPURate = make([]float64, len(info.PerCPU))
+		var delta uint64
+		for i, count := range info.PerCPU {
+			// Counters reset when an IRQ is freed and reallocated
+			if count < prevCounts[i] {
+				continue
+			}
+			d := count - prevCounts[i]
+			info.PerCPURate[i] = float64(d) / timeDiff
+			delta += d
+		}
+		info.Rate = float64(delta) / timeDiff
+	}
+}
+
+func interruptCounters(infos []*models.InterruptInfo) map[string][]uint64 {
+	counters := make(map[string][]uint64, len(infos))
+	for _, info := range infos {
+		if info.PerCPU == nil {
+			counters[info.IRQ] = []uint64{info.Total}
+			continue
+		}
+		counters[info.IRQ] = info.PerCPU
+	}
+	return counters
+}
+
+func sortInterrupts(infos []*models.InterruptInfo) {
+	sort.SliceStable(infos, func(i, j int) bool {
+		if infos[i].Rate != infos[j].Rate {
+			return infos[i].Rate > infos[j].Rate
+		}
+		return infos[i].Total > infos[j].Total
+	})
+}
+
+func encodeInterruptsCursor(cursor InterruptsCursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parseInterruptsCursor(cursorStr string) (InterruptsCursor, error) {
+	var cursor InterruptsCursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
+}
@@ -0,0 +1,9 @@
+//go:build darwin
+
+package gops
+
+import "fmt"
+
+func (self *GopsUtil) readInterruptFiles() (string, string, error) {
+	return "", "", fmt.Errorf("interrupt statistics are not supported on darwin")
+}
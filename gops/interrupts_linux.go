@@ -0,0 +1,20 @@
+//go:build linux
+
+package gops
+
+const (
+	interruptsPath = "/proc/interrupts"
+	softirqsPath   = "/proc/softirqs"
+)
+
+func (self *GopsUtil) readInterruptFiles() (string, string, error) {
+	interrupts, err := self.fs.ReadFile(interruptsPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	// softirqs is optional; the hardirq table is still useful on its own
+	softirqs, _ := self.fs.ReadFile(softirqsPath)
+
+	return string(interrupts), string(softirqs), nil
+}
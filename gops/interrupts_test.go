@@ -0,0 +1,104 @@
+package gops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testInterrupts = `           CPU0       CPU1       CPU2
+  0:         22          0          0  IR-IO-APIC    2-edge      timer
+ 24:        100       5000          0  IR-PCI-MSI 327680-edge      nvme0q1
+NMI:          1          2          3   Non-maskable interrupts
+ERR:          4
+`
+
+const testSoftirqs = `                    CPU0       CPU1       CPU2
+          HI:          0          0          0
+      NET_RX:         10        900          5
+`
+
+func TestParseInterruptTable(t *testing.T) {
+	cpus, infos, err := parseInterruptTable(testInterrupts)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, cpus)
+	require.Len(t, infos, 4)
+
+	assert.Equal(t, "24", infos[1].IRQ)
+	assert.Equal(t, "IR-PCI-MSI 327680-edge nvme0q1", infos[1].Description)
+	assert.Equal(t, []uint64{100, 5000, 0}, infos[1].PerCPU)
+	assert.Equal(t, uint64(5100), infos[1].Total)
+
+	assert.Equal(t, "Non-maskable interrupts", infos[2].Description)
+
+	assert.Equal(t, "ERR", infos[3].IRQ)
+	assert.Nil(t, infos[3].PerCPU)
+	assert.Equal(t, uint64(4), infos[3].Total)
+	assert.Empty(t, infos[3].Description)
+}
+
+func TestParseInterruptTableSoftirqs(t *testing.T) {
+	_, infos, err := parseInterruptTable(testSoftirqs)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	assert.Equal(t, "NET_RX", infos[1].IRQ)
+	assert.Equal(t, uint64(915), infos[1].Total)
+	assert.Empty(t, infos[1].Description)
+}
+
+func TestParseInterruptTableInvalidHeader(t *testing.T) {
+	_, _, err := parseInterruptTable("garbage\n 0: 1\n")
+	assert.Error(t, err)
+
+	_, _, err = parseInterruptTable("")
+	assert.Error(t, err)
+}
+
+func TestApplyInterruptRates(t *testing.T) {
+	_, infos, err := parseInterruptTable(testInterrupts)
+	require.NoError(t, err)
+
+	prev := map[string][]uint64{
+		"24":  {50, 3000, 0},
+		"NMI": {1, 2},
+		"ERR": {2},
+	}
+	applyInterruptRates(infos, prev, 2.0)
+
+	assert.Equal(t, 1025.0, infos[1].Rate)
+	assert.Equal(t, []float64{25, 1000, 0}, infos[1].PerCPURate)
+
+	// Mismatched CPU count (hotplug) and unknown IRQs get no rate
+	assert.Nil(t, infos[2].PerCPURate)
+	assert.Nil(t, infos[0].PerCPURate)
+
+	// System-wide rows get a rate from their total but none per CPU
+	assert.Equal(t, 1.0, infos[3].Rate)
+	assert.Empty(t, infos[3].PerCPURate)
+	assert.Equal(t, []uint64{4}, interruptCounters(infos)["ERR"])
+
+	sortInterrupts(infos)
+	assert.Equal(t, "24", infos[0].IRQ)
+}
+
+func TestInterruptsCursorRoundTrip(t *testing.T) {
+	cursor := InterruptsCursor{
+		Timestamp:  time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Interrupts: map[string][]uint64{"24": {1, 2}},
+		Softirqs:   map[string][]uint64{"NET_RX": {3, 4}},
+	}
+
+	encoded, err := encodeInterruptsCursor(cursor)
+	require.NoError(t, err)
+
+	decoded, err := parseInterruptsCursor(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Timestamp.Unix(), decoded.Timestamp.Unix())
+	assert.Equal(t, cursor.Interrupts, decoded.Interrupts)
+	assert.Equal(t, cursor.Softirqs, decoded.Softirqs)
+
+	_, err = parseInterruptsCursor("not-valid-base64!!!")
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ package gops
 import (
 	"bufio"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -54,7 +55,7 @@ func (self *GopsUtil) GetMemoryInfo() (*models.MemoryInfo, error) {
 		usedPercent = float64(used) / float64(total) * 100
 	}
 
-	return &models.MemoryInfo{
+	info := &models.MemoryInfo{
 		Total:        total,
 		Used:         used,
 		UsedPercent:  usedPercent,
@@ -67,7 +68,118 @@ func (self *GopsUtil) GetMemoryInfo() (*models.MemoryInfo, error) {
 		ZfsArcSize:   arcSizeKB,
 		SwapTotal:    v.SwapTotal / 1024,
 		SwapFree:     v.SwapFree / 1024,
-	}, nil
+		Details:      self.readMemoryDetails(meminfoPath, ksmSysfsPath),
+	}
+
+	if cg := self.cgroupLimits(); cg != nil && cg.MemoryDir != "" {
+		if mem, err := self.readCgroupMemory(cg.MemoryDir); err == nil {
+			applyCgroupMemory(info, mem, cg)
+		}
+	}
+
+	return info, nil
+}
+
+const (
+	meminfoPath  = "/proc/meminfo"
+	ksmSysfsPath = "/sys/kernel/mm/ksm"
+)
+
+// readMemoryDetails parses every /proc/meminfo field (kB, except the
+// HugePages_* counts) plus the KSM counters. Returns nil if meminfo is unreadable.
+func (self *GopsUtil) readMemoryDetails(meminfo, ksmDir string) *models.MemoryDetails {
+	fields, err := self.readMeminfoFields(meminfo)
+	if err != nil {
+		return nil
+	}
+
+	return &models.MemoryDetails{
+		Active:         fields["Active"],
+		Inactive:       fields["Inactive"],
+		ActiveAnon:     fields["Active(anon)"],
+		InactiveAnon:   fields["Inactive(anon)"],
+		ActiveFile:     fields["Active(file)"],
+		InactiveFile:   fields["Inactive(file)"],
+		Unevictable:    fields["Unevictable"],
+		Mlocked:        fields["Mlocked"],
+		SwapCached:     fields["SwapCached"],
+		Dirty:          fields["Dirty"],
+		Writeback:      fields["Writeback"],
+		AnonPages:      fields["AnonPages"],
+		Mapped:         fields["Mapped"],
+		Shmem:          fields["Shmem"],
+		Slab:           fields["Slab"],
+		SReclaimable:   fields["SReclaimable"],
+		SUnreclaim:     fields["SUnreclaim"],
+		KernelStack:    fields["KernelStack"],
+		PageTables:     fields["PageTables"],
+		Percpu:         fields["Percpu"],
+		VmallocUsed:    fields["VmallocUsed"],
+		CommitLimit:    fields["CommitLimit"],
+		CommittedAS:    fields["Committed_AS"],
+		AnonHugePages:  fields["AnonHugePages"],
+		ShmemHugePages: fields["ShmemHugePages"],
+		FileHugePages:  fields["FileHugePages"],
+		HugePages: models.HugePagesInfo{
+			Total:    fields["HugePages_Total"],
+			Free:     fields["HugePages_Free"],
+			Reserved: fields["HugePages_Rsvd"],
+			Surplus:  fields["HugePages_Surp"],
+			PageSize: fields["Hugepagesize"],
+			Hugetlb:  fields["Hugetlb"],
+		},
+		KSM:     self.readKSMInfo(ksmDir),
+		Meminfo: fields,
+	}
+}
+
+func (self *GopsUtil) readMeminfoFields(path string) (map[string]uint64, error) {
+	data, err := self.fs.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseMeminfoFields(string(data)), nil
+}
+
+func parseMeminfoFields(data string) map[string]uint64 {
+	fields := make(map[string]uint64)
+	for _, line := range strings.Split(data, "\n") {
+		key, rest, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		parts := strings.Fields(rest)
+		if len(parts) == 0 {
+			continue
+		}
+		value, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		fields[strings.TrimSpace(key)] = value
+	}
+	return fields
+}
+
+func (self *GopsUtil) readKSMInfo(ksmDir string) *models.KSMInfo {
+	run, err := self.readSysfsUint(filepath.Join(ksmDir, "run"))
+	if err != nil {
+		return nil
+	}
+
+	ksm := &models.KSMInfo{Running: run == 1}
+	ksm.PagesShared, _ = self.readSysfsUint(filepath.Join(ksmDir, "pages_shared"))
+	ksm.PagesSharing, _ = self.readSysfsUint(filepath.Join(ksmDir, "pages_sharing"))
+	ksm.PagesUnshared, _ = self.readSysfsUint(filepath.Join(ksmDir, "pages_unshared"))
+	ksm.PagesVolatile, _ = self.readSysfsUint(filepath.Join(ksmDir, "pages_volatile"))
+	ksm.FullScans, _ = self.readSysfsUint(filepath.Join(ksmDir, "full_scans"))
+	// pages_sharing counts the extra mappings deduplicated onto shared pages
+	ksm.Saved = ksm.PagesSharing * uint64(os.Getpagesize()) / 1024
+	// general_profit (6.1+) is in bytes and goes negative when metadata outweighs savings
+	if profit, err := strconv.ParseInt(self.readSysfsString(filepath.Join(ksmDir, "general_profit")), 10, 64); err == nil {
+		ksm.GeneralProfit = profit / 1024
+	}
+	return ksm
 }
 
 func readZfsArcStats() (size uint64, cMin uint64) {
@@ -0,0 +1,75 @@
+//go:build linux
+
+package gops
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMeminfo = `MemTotal:        6152192 kB
+MemFree:         4215932 kB
+Active(anon):         24 kB
+Dirty:             48096 kB
+Writeback:           128 kB
+Slab:              84600 kB
+SReclaimable:      63388 kB
+SUnreclaim:        21212 kB
+KernelStack:        1280 kB
+PageTables:         2120 kB
+CommitLimit:     3076096 kB
+Committed_AS:     529056 kB
+AnonHugePages:      4096 kB
+HugePages_Total:       8
+HugePages_Free:        6
+HugePages_Rsvd:        1
+HugePages_Surp:        0
+Hugepagesize:       2048 kB
+Hugetlb:           16384 kB
+`
+
+func TestReadMemoryDetails(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/proc/meminfo":                     testMeminfo,
+		"/sys/kernel/mm/ksm/run":            "1",
+		"/sys/kernel/mm/ksm/pages_shared":   "100",
+		"/sys/kernel/mm/ksm/pages_sharing":  "400",
+		"/sys/kernel/mm/ksm/pages_unshared": "50",
+		"/sys/kernel/mm/ksm/full_scans":     "3",
+		"/sys/kernel/mm/ksm/general_profit": "-8192",
+	})
+
+	details := gops.readMemoryDetails(meminfoPath, ksmSysfsPath)
+	require.NotNil(t, details)
+
+	assert.Equal(t, uint64(24), details.ActiveAnon)
+	assert.Equal(t, uint64(48096), details.Dirty)
+	assert.Equal(t, uint64(128), details.Writeback)
+	assert.Equal(t, uint64(21212), details.SUnreclaim)
+	assert.Equal(t, uint64(529056), details.CommittedAS)
+	assert.Equal(t, uint64(3076096), details.CommitLimit)
+	assert.Equal(t, uint64(4096), details.AnonHugePages)
+	assert.Equal(t, uint64(8), details.HugePages.Total)
+	assert.Equal(t, uint64(1), details.HugePages.Reserved)
+	assert.Equal(t, uint64(2048), details.HugePages.PageSize)
+	assert.Equal(t, uint64(6152192), details.Meminfo["MemTotal"])
+
+	require.NotNil(t, details.KSM)
+	assert.True(t, details.KSM.Running)
+	assert.Equal(t, uint64(400), details.KSM.PagesSharing)
+	assert.Equal(t, uint64(400*os.Getpagesize()/1024), details.KSM.Saved)
+	assert.Equal(t, int64(-8), details.KSM.GeneralProfit)
+}
+
+func TestReadMemoryDetailsMissing(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	assert.Nil(t, gops.readMemoryDetails(meminfoPath, ksmSysfsPath))
+
+	gops, _ = newFixtureGops(map[string]string{"/proc/meminfo": testMeminfo})
+	details := gops.readMemoryDetails(meminfoPath, ksmSysfsPath)
+	require.NotNil(t, details)
+	assert.Nil(t, details.KSM)
+}
@@ -22,8 +22,20 @@ var availableModules = []string{
 	"processes",
 	"system",
 	"hardware",
+	"topology",
 	"gpu",
 	"gpu-temp",
+	"pressure",
+	"interrupts",
+	"sensors",
+	"zram",
+	"numa",
+	"vmstat",
+	"blockdevices",
+	"smart",
+	"storagepools",
+	"connections",
+	"netstack",
 }
 
 func (self *GopsUtil) GetModules() (*models.ModulesInfo, error) {
@@ -33,15 +45,22 @@ func (self *GopsUtil) GetModules() (*models.ModulesInfo, error) {
 }
 
 type MetaParams struct {
-	SortBy         ProcSortBy
-	ProcLimit      int
-	EnableCPU      bool
-	MergeChildren  bool
-	GPUPciIds      []string
-	CPUCursor      string
-	ProcCursor     string
-	NetRateCursor  string
-	DiskRateCursor string
+	SortBy           ProcSortBy
+	ProcLimit        int
+	EnableCPU        bool
+	MergeChildren    bool
+	GPUPciIds        []string
+	CPUCursor        string
+	ProcCursor       string
+	NetRateCursor    string
+	DiskRateCursor   string
+	MountsCursor     string
+	PressureCursor   string
+	InterruptsCursor string
+	SystemCursor     string
+	NUMACursor       string
+	VMStatCursor     string
+	NetStackCursor   string
 }
 
 func (self *GopsUtil) GetMeta(ctx context.Context, modules []string, params MetaParams) (*models.MetaInfo, error) {
@@ -76,8 +95,29 @@ func (self *GopsUtil) GetMeta(ctx context.Context, modules []string, params Meta
 				meta.DiskRate = diskRate
 			}
 		case "diskmounts":
-			if mounts, err := self.GetDiskMounts(); err == nil {
-				meta.DiskMounts = mounts
+			if mounts, err := self.GetDiskMountsWithCursor(params.MountsCursor); err == nil {
+				meta.DiskMounts = mounts.Mounts
+				meta.MountsCursor = mounts.Cursor
+			}
+		case "blockdevices":
+			if devices, err := self.GetBlockDevices(); err == nil {
+				meta.BlockDevices = devices
+			}
+		case "smart":
+			if smart, err := self.GetSmart(); err == nil {
+				meta.Smart = smart
+			}
+		case "storagepools":
+			if pools, err := self.GetStoragePools(); err == nil {
+				meta.StoragePools = pools
+			}
+		case "connections":
+			if conns, err := self.GetConnections(ConnectionFilter{}); err == nil {
+				meta.Connections = conns
+			}
+		case "netstack":
+			if netstack, err := self.GetNetStack(params.NetStackCursor); err == nil {
+				meta.NetStack = netstack
 			}
 		case "processes":
 			if result, err := self.GetProcessesWithCursor(params.SortBy, params.ProcLimit, params.EnableCPU, params.ProcCursor, params.MergeChildren); err == nil {
@@ -85,17 +125,45 @@ func (self *GopsUtil) GetMeta(ctx context.Context, modules []string, params Meta
 				meta.Cursor = result.Cursor
 			}
 		case "system":
-			if sys, err := self.GetSystemInfo(); err == nil {
+			if sys, err := self.GetSystemInfoWithCursor(params.SystemCursor); err == nil {
 				meta.System = sys
 			}
 		case "hardware":
 			if hw, err := self.GetSystemHardware(); err == nil {
 				meta.Hardware = hw
 			}
+		case "topology":
+			if topology, err := self.GetCPUTopology(); err == nil {
+				meta.Topology = topology
+			}
 		case "gpu", "gpu-temp":
 			if gpu, err := self.GetGPUInfoWithTemp(params.GPUPciIds); err == nil {
 				meta.GPU = gpu
 			}
+		case "pressure":
+			if pressure, err := self.GetPressure(params.PressureCursor); err == nil {
+				meta.Pressure = pressure
+			}
+		case "sensors":
+			if sensors, err := self.GetSensors(); err == nil {
+				meta.Sensors = sensors
+			}
+		case "zram":
+			if zram, err := self.GetZram(); err == nil {
+				meta.Zram = zram
+			}
+		case "numa":
+			if numa, err := self.GetNUMA(params.NUMACursor); err == nil {
+				meta.NUMA = numa
+			}
+		case "vmstat":
+			if vmstat, err := self.GetVMStat(params.VMStatCursor); err == nil {
+				meta.VMStat = vmstat
+			}
+		case "interrupts":
+			if interrupts, err := self.GetInterrupts(params.InterruptsCursor); err == nil {
+				meta.Interrupts = interrupts
+			}
 		default:
 			return nil, fmt.Errorf("unknown module: %s", module)
 		}
@@ -104,6 +172,9 @@ func (self *GopsUtil) GetMeta(ctx context.Context, modules []string, params Meta
 	return meta, nil
 }
 
+// loadAllModules fetches every module except smart, storagepools and
+// connections. They run smartctl for each drive, zpool status and a readlink
+// of every open fd, so they are only loaded when named explicitly.
 func (self *GopsUtil) loadAllModules(ctx context.Context, params MetaParams) (*models.MetaInfo, error) {
 	meta := &models.MetaInfo{}
 	var mu sync.Mutex
@@ -127,6 +198,23 @@ func (self *GopsUtil) loadAllModules(ctx context.Context, params MetaParams) (*m
 		return nil
 	})
 
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		netstack, err := self.GetNetStack(params.NetStackCursor)
+		if err != nil {
+			log.Warn("failed to get netstack", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.NetStack = netstack
+		mu.Unlock()
+		return nil
+	})
+
 	g.Go(func() error {
 		select {
 		case <-ctx.Done():
@@ -218,13 +306,31 @@ func (self *GopsUtil) loadAllModules(ctx context.Context, params MetaParams) (*m
 			return ctx.Err()
 		default:
 		}
-		mounts, err := self.GetDiskMounts()
+		mounts, err := self.GetDiskMountsWithCursor(params.MountsCursor)
 		if err != nil {
 			log.Warn("failed to get disk mounts", "error", err)
 			return nil
 		}
 		mu.Lock()
-		meta.DiskMounts = mounts
+		meta.DiskMounts = mounts.Mounts
+		meta.MountsCursor = mounts.Cursor
+		mu.Unlock()
+		return nil
+	})
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		devices, err := self.GetBlockDevices()
+		if err != nil {
+			log.Warn("failed to get block devices", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.BlockDevices = devices
 		mu.Unlock()
 		return nil
 	})
@@ -253,7 +359,7 @@ func (self *GopsUtil) loadAllModules(ctx context.Context, params MetaParams) (*m
 			return ctx.Err()
 		default:
 		}
-		sys, err := self.GetSystemInfo()
+		sys, err := self.GetSystemInfoWithCursor(params.SystemCursor)
 		if err != nil {
 			log.Warn("failed to get system info", "error", err)
 			return nil
@@ -281,6 +387,23 @@ func (self *GopsUtil) loadAllModules(ctx context.Context, params MetaParams) (*m
 		return nil
 	})
 
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		topology, err := self.GetCPUTopology()
+		if err != nil {
+			log.Warn("failed to get cpu topology", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.Topology = topology
+		mu.Unlock()
+		return nil
+	})
+
 	g.Go(func() error {
 		select {
 		case <-ctx.Done():
@@ -298,6 +421,108 @@ func (self *GopsUtil) loadAllModules(ctx context.Context, params MetaParams) (*m
 		return nil
 	})
 
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pressure, err := self.GetPressure(params.PressureCursor)
+		if err != nil {
+			log.Warn("failed to get pressure info", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.Pressure = pressure
+		mu.Unlock()
+		return nil
+	})
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		interrupts, err := self.GetInterrupts(params.InterruptsCursor)
+		if err != nil {
+			log.Warn("failed to get interrupts info", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.Interrupts = interrupts
+		mu.Unlock()
+		return nil
+	})
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		sensors, err := self.GetSensors()
+		if err != nil {
+			log.Warn("failed to get sensors", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.Sensors = sensors
+		mu.Unlock()
+		return nil
+	})
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		zram, err := self.GetZram()
+		if err != nil {
+			log.Warn("failed to get zram info", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.Zram = zram
+		mu.Unlock()
+		return nil
+	})
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		numa, err := self.GetNUMA(params.NUMACursor)
+		if err != nil {
+			log.Warn("failed to get NUMA info", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.NUMA = numa
+		mu.Unlock()
+		return nil
+	})
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		vmstat, err := self.GetVMStat(params.VMStatCursor)
+		if err != nil {
+			log.Warn("failed to get vmstat", "error", err)
+			return nil
+		}
+		mu.Lock()
+		meta.VMStat = vmstat
+		mu.Unlock()
+		return nil
+	})
+
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
@@ -137,6 +137,62 @@ func (_c *MockFileSystem_ReadFile_Call) RunAndReturn(run func(string) ([]byte, e
 	return _c
 }
 
+// Readlink provides a mock function with given fields: name
+func (_m *MockFileSystem) Readlink(name string) (string, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Readlink")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSystem_Readlink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Readlink'
+type MockFileSystem_Readlink_Call struct {
+	*mock.Call
+}
+
+// Readlink is a helper method to define mock.On call
+//   - name string
+func (_e *MockFileSystem_Expecter) Readlink(name interface{}) *MockFileSystem_Readlink_Call {
+	return &MockFileSystem_Readlink_Call{Call: _e.mock.On("Readlink", name)}
+}
+
+func (_c *MockFileSystem_Readlink_Call) Run(run func(name string)) *MockFileSystem_Readlink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSystem_Readlink_Call) Return(_a0 string, _a1 error) *MockFileSystem_Readlink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSystem_Readlink_Call) RunAndReturn(run func(string) (string, error)) *MockFileSystem_Readlink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stat provides a mock function with given fields: name
 func (_m *MockFileSystem) Stat(name string) (fs.FileInfo, error) {
 	ret := _m.Called(name)
@@ -1,6 +1,25 @@
 package gops
 
-import "github.com/AvengeMedia/dgop/models"
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+	gnet "github.com/shirou/gopsutil/v4/net"
+)
+
+// netLink holds the sysfs link attributes gopsutil does not report
+type netLink struct {
+	OperState string
+	Carrier   bool
+	Speed     int
+	Duplex    string
+	Driver    string
+	Type      string
+}
 
 func (self *GopsUtil) GetNetworkInfo() ([]*models.NetworkInfo, error) {
 	netIO, err := self.netProvider.IOCounters(true)
@@ -11,15 +30,96 @@ func (self *GopsUtil) GetNetworkInfo() ([]*models.NetworkInfo, error) {
 	ifaces, _ := self.netProvider.Interfaces()
 	index := indexInterfacesByName(ifaces)
 
+	wireless, _ := self.readWirelessStats()
+
 	res := make([]*models.NetworkInfo, 0)
 	for _, n := range netIO {
-		if isUsableNetworkInterface(n.Name, index) {
-			res = append(res, &models.NetworkInfo{
-				Name: n.Name,
-				Rx:   n.BytesRecv,
-				Tx:   n.BytesSent,
-			})
+		if !self.isUsableNetworkInterface(n.Name, index) {
+			continue
+		}
+
+		info := &models.NetworkInfo{
+			Name: n.Name,
+			Rx:   n.BytesRecv,
+			Tx:   n.BytesSent,
+		}
+		if iface, ok := index[n.Name]; ok {
+			applyInterfaceStat(info, iface)
+		}
+		if link, err := self.readNetworkLink(n.Name); err == nil {
+			info.OperState = link.OperState
+			info.Carrier = link.Carrier
+			info.Speed = link.Speed
+			info.Duplex = link.Duplex
+			info.Driver = link.Driver
+			info.Type = link.Type
 		}
+		if w, ok := wireless[n.Name]; ok {
+			info.Wireless = w
+			info.Type = "wireless"
+		}
+
+		res = append(res, info)
 	}
 	return res, nil
 }
+
+func applyInterfaceStat(info *models.NetworkInfo, iface gnet.InterfaceStat) {
+	info.MAC = iface.HardwareAddr
+	info.MTU = iface.MTU
+
+	for _, addr := range iface.Addrs {
+		ip, _, err := net.ParseCIDR(addr.Addr)
+		if err != nil {
+			ip = net.ParseIP(addr.Addr)
+		}
+		switch {
+		case ip == nil:
+			continue
+		case ip.To4() != nil:
+			info.IPv4 = append(info.IPv4, addr.Addr)
+		default:
+			info.IPv6 = append(info.IPv6, addr.Addr)
+		}
+	}
+}
+
+// parseNetWireless reads /proc/net/wireless; values carry a trailing '.'
+// and a noise of -256 means the driver does not report it
+func parseNetWireless(data []byte) map[string]*models.WirelessInfo {
+	stats := make(map[string]*models.WirelessInfo)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		name, rest, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) < 4 {
+			continue
+		}
+
+		quality, err1 := parseWirelessValue(fields[1])
+		signal, err2 := parseWirelessValue(fields[2])
+		noise, err3 := parseWirelessValue(fields[3])
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		if noise == -256 {
+			noise = 0
+		}
+
+		stats[strings.TrimSpace(name)] = &models.WirelessInfo{
+			Quality: quality,
+			Signal:  signal,
+			Noise:   noise,
+		}
+	}
+
+	return stats
+}
+
+func parseWirelessValue(field string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSuffix(field, "."), 64)
+}
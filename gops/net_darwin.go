@@ -2,14 +2,20 @@
 
 package gops
 
-import "strings"
-
-func matchesNetworkInterface(name string) bool {
-	prefixes := []string{"en", "utun", "bridge", "awdl", "llw"}
-	for _, prefix := range prefixes {
-		if strings.HasPrefix(name, prefix) {
-			return true
-		}
-	}
-	return false
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readNetworkType(name string) string {
+	return ""
+}
+
+func (self *GopsUtil) readNetworkLink(name string) (*netLink, error) {
+	return nil, fmt.Errorf("link details are not supported on darwin")
+}
+
+func (self *GopsUtil) readWirelessStats() (map[string]*models.WirelessInfo, error) {
+	return nil, fmt.Errorf("wireless statistics are not supported on darwin")
 }
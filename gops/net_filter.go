@@ -1,11 +1,155 @@
 package gops
 
 import (
+	"fmt"
+	"path"
+	"slices"
 	"strings"
 
+	"github.com/AvengeMedia/dgop/models"
 	gnet "github.com/shirou/gopsutil/v4/net"
 )
 
+type NetworkPreset string
+
+const (
+	NetworkPresetDefault  NetworkPreset = "default"
+	NetworkPresetAll      NetworkPreset = "all"
+	NetworkPresetPhysical NetworkPreset = "physical"
+)
+
+// Container and VM plumbing that would otherwise crowd out the real links,
+// plus the macOS tunnel, capture and USB bus pseudo-interfaces
+var defaultNetworkExcludes = []string{
+	"lo", "lo0", "docker*", "br-*", "veth*", "virbr*", "vnet*", "cali*", "cni*", "flannel*",
+	"gif*", "stf*", "anpi*", "ap[0-9]*", "XHC*", "pktap*", "iptap*",
+}
+
+var networkInterfaceTypes = []string{
+	"ethernet", "wireless", "wwan", "infiniband", "bridge", "bond", "tun", "tunnel", "ppp", "virtual", "loopback",
+}
+
+var physicalNetworkTypes = []string{"ethernet", "wireless", "wwan", "infiniband"}
+
+// networkPolicy is a NetworkFilter with its preset expanded. An interface is
+// shown when it matches no exclude glob, matches an include glob if any are
+// given, and has one of the listed types. Interfaces whose type cannot be
+// determined pass the type check.
+type networkPolicy struct {
+	include []string
+	exclude []string
+	types   []string
+}
+
+var defaultNetworkPolicy = &networkPolicy{exclude: defaultNetworkExcludes}
+
+func newNetworkPolicy(filter models.NetworkFilter) (*networkPolicy, error) {
+	policy := &networkPolicy{}
+
+	switch NetworkPreset(strings.ToLower(strings.TrimSpace(filter.Preset))) {
+	case "", NetworkPresetDefault:
+		policy.exclude = slices.Clone(defaultNetworkExcludes)
+	case NetworkPresetAll:
+		policy.exclude = []string{"lo", "lo0"}
+	case NetworkPresetPhysical:
+		policy.exclude = []string{"lo", "lo0"}
+		policy.types = physicalNetworkTypes
+	default:
+		return nil, fmt.Errorf("invalid network preset %q (expected default, all or physical)", filter.Preset)
+	}
+
+	for _, glob := range slices.Concat(filter.Include, filter.Exclude) {
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("invalid interface pattern %q: %w", glob, err)
+		}
+	}
+	policy.include = filter.Include
+	policy.exclude = append(policy.exclude, filter.Exclude...)
+
+	if len(filter.Types) > 0 {
+		for _, t := range filter.Types {
+			if !slices.Contains(networkInterfaceTypes, t) {
+				return nil, fmt.Errorf("invalid interface type %q (expected one of %s)", t, strings.Join(networkInterfaceTypes, ", "))
+			}
+		}
+		policy.types = filter.Types
+	}
+
+	return policy, nil
+}
+
+func (p *networkPolicy) allows(name, ifaceType string) bool {
+	if matchesAnyGlob(p.exclude, name) {
+		return false
+	}
+	if len(p.include) > 0 && !matchesAnyGlob(p.include, name) {
+		return false
+	}
+	if len(p.types) > 0 && ifaceType != "" && !slices.Contains(p.types, ifaceType) {
+		return false
+	}
+	return true
+}
+
+func matchesAnyGlob(globs []string, name string) bool {
+	for _, glob := range globs {
+		if ok, _ := path.Match(glob, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// OverlayNetworkFilter returns base refined by override: a set preset,
+// include or types replaces base's, while excludes are added to base's so
+// flags and query params hide interfaces on top of the config file.
+func OverlayNetworkFilter(base, override models.NetworkFilter) models.NetworkFilter {
+	if override.Preset != "" {
+		base.Preset = override.Preset
+	}
+	if len(override.Include) > 0 {
+		base.Include = override.Include
+	}
+	if len(override.Exclude) > 0 {
+		base.Exclude = slices.Concat(base.Exclude, override.Exclude)
+	}
+	if len(override.Types) > 0 {
+		base.Types = override.Types
+	}
+	return base
+}
+
+// SetNetworkFilter selects which interfaces network, net-rate and the TUI
+// report.
+func (self *GopsUtil) SetNetworkFilter(filter models.NetworkFilter) error {
+	policy, err := newNetworkPolicy(filter)
+	if err != nil {
+		return err
+	}
+	self.netFilter = filter
+	self.netPolicy = policy
+	return nil
+}
+
+// WithNetworkFilter returns a clone of self with filter overlaid on its filter.
+func (self *GopsUtil) WithNetworkFilter(filter models.NetworkFilter) (*GopsUtil, error) {
+	if filter.Preset == "" && len(filter.Include) == 0 && len(filter.Exclude) == 0 && len(filter.Types) == 0 {
+		return self, nil
+	}
+	filtered := self.clone()
+	if err := filtered.SetNetworkFilter(OverlayNetworkFilter(self.netFilter, filter)); err != nil {
+		return nil, err
+	}
+	return filtered, nil
+}
+
+func (self *GopsUtil) networkPolicy() *networkPolicy {
+	if self.netPolicy == nil {
+		return defaultNetworkPolicy
+	}
+	return self.netPolicy
+}
+
 func indexInterfacesByName(ifaces []gnet.InterfaceStat) map[string]gnet.InterfaceStat {
 	index := make(map[string]gnet.InterfaceStat, len(ifaces))
 	for _, iface := range ifaces {
@@ -23,8 +167,19 @@ func hasInterfaceFlag(flags []string, needle string) bool {
 	return false
 }
 
-func isUsableNetworkInterface(name string, ifaceMap map[string]gnet.InterfaceStat) bool {
-	if !matchesNetworkInterface(name) {
+// AllowsNetworkInterface reports whether the network filter shows name
+func (self *GopsUtil) AllowsNetworkInterface(name string) bool {
+	policy := self.networkPolicy()
+
+	ifaceType := ""
+	if len(policy.types) > 0 {
+		ifaceType = self.readNetworkType(name)
+	}
+	return policy.allows(name, ifaceType)
+}
+
+func (self *GopsUtil) isUsableNetworkInterface(name string, ifaceMap map[string]gnet.InterfaceStat) bool {
+	if !self.AllowsNetworkInterface(name) {
 		return false
 	}
 
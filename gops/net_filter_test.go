@@ -0,0 +1,148 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/gops/mocks"
+	"github.com/AvengeMedia/dgop/models"
+	gnet "github.com/shirou/gopsutil/v4/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultNetworkPolicy(t *testing.T) {
+	policy, err := newNetworkPolicy(models.NetworkFilter{})
+	require.NoError(t, err)
+
+	shown := []string{
+		"eth0", "enp3s0", "wlp2s0", "wlan0", "en0", "utun4",
+		"wg0", "tailscale0", "bond0", "eth0.100", "br0", "usb0", "wwan0", "lan", "lxcbr0",
+		"bridge0", "awdl0", "llw0", "apcli0",
+	}
+	for _, name := range shown {
+		assert.True(t, policy.allows(name, ""), "%s should be shown", name)
+	}
+
+	hidden := []string{"lo", "lo0", "docker0", "br-1234567890ab", "veth1a2b", "virbr0", "vnet3", "cali12ab", "cni0", "flannel.1",
+		"gif0", "stf0", "anpi1", "ap1", "XHC20", "pktap0", "iptap0",
+	}
+	for _, name := range hidden {
+		assert.False(t, policy.allows(name, ""), "%s should be hidden", name)
+	}
+}
+
+func TestNetworkPolicyPresets(t *testing.T) {
+	all, err := newNetworkPolicy(models.NetworkFilter{Preset: "all"})
+	require.NoError(t, err)
+	assert.True(t, all.allows("docker0", "bridge"))
+	assert.True(t, all.allows("veth1a2b", "virtual"))
+	assert.False(t, all.allows("lo", "loopback"))
+
+	physical, err := newNetworkPolicy(models.NetworkFilter{Preset: "Physical"})
+	require.NoError(t, err)
+	assert.True(t, physical.allows("enp3s0", "ethernet"))
+	assert.True(t, physical.allows("wlp2s0", "wireless"))
+	assert.True(t, physical.allows("wwan0", "wwan"))
+	assert.False(t, physical.allows("wg0", "tunnel"))
+	assert.False(t, physical.allows("br0", "bridge"))
+	assert.False(t, physical.allows("veth1a2b", "virtual"))
+	assert.True(t, physical.allows("en0", ""), "unknown types pass")
+}
+
+func TestNetworkPolicyIncludeExcludeTypes(t *testing.T) {
+	policy, err := newNetworkPolicy(models.NetworkFilter{
+		Include: []string{"en*", "wg*"},
+		Exclude: []string{"enx*"},
+	})
+	require.NoError(t, err)
+	assert.True(t, policy.allows("enp3s0", ""))
+	assert.True(t, policy.allows("wg0", ""))
+	assert.False(t, policy.allows("enx00e04c680001", ""))
+	assert.False(t, policy.allows("wlp2s0", ""))
+
+	policy, err = newNetworkPolicy(models.NetworkFilter{Preset: "all", Types: []string{"bridge", "bond"}})
+	require.NoError(t, err)
+	assert.True(t, policy.allows("docker0", "bridge"))
+	assert.True(t, policy.allows("bond0", "bond"))
+	assert.False(t, policy.allows("eth0", "ethernet"))
+}
+
+func TestNetworkPolicyInvalid(t *testing.T) {
+	_, err := newNetworkPolicy(models.NetworkFilter{Preset: "wired"})
+	assert.Error(t, err)
+
+	_, err = newNetworkPolicy(models.NetworkFilter{Include: []string{"eth["}})
+	assert.Error(t, err)
+
+	_, err = newNetworkPolicy(models.NetworkFilter{Types: []string{"modem"}})
+	assert.Error(t, err)
+}
+
+func TestOverlayNetworkFilter(t *testing.T) {
+	base := models.NetworkFilter{Preset: "physical", Exclude: []string{"enx*"}}
+
+	merged := OverlayNetworkFilter(base, models.NetworkFilter{Include: []string{"wg0"}})
+	assert.Equal(t, models.NetworkFilter{Preset: "physical", Include: []string{"wg0"}, Exclude: []string{"enx*"}}, merged)
+
+	merged = OverlayNetworkFilter(base, models.NetworkFilter{Preset: "all", Exclude: []string{"tap*"}})
+	assert.Equal(t, models.NetworkFilter{Preset: "all", Exclude: []string{"enx*", "tap*"}}, merged)
+	assert.Equal(t, []string{"enx*"}, base.Exclude, "base is unchanged")
+}
+
+func TestWithNetworkFilter(t *testing.T) {
+	g := NewGopsUtilWithProviders(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, g.SetNetworkFilter(models.NetworkFilter{Exclude: []string{"wg*"}}))
+
+	same, err := g.WithNetworkFilter(models.NetworkFilter{})
+	require.NoError(t, err)
+	assert.Same(t, g, same)
+
+	all, err := g.WithNetworkFilter(models.NetworkFilter{Preset: "all"})
+	require.NoError(t, err)
+	assert.True(t, all.networkPolicy().allows("docker0", ""))
+	assert.False(t, all.networkPolicy().allows("wg0", ""))
+	assert.False(t, g.networkPolicy().allows("docker0", ""), "original is unchanged")
+
+	_, err = g.WithNetworkFilter(models.NetworkFilter{Preset: "bogus"})
+	assert.Error(t, err)
+}
+
+func TestGetNetworkRatesAppliesFilter(t *testing.T) {
+	net := mocks.NewMockNetworkInfoProvider(t)
+	net.EXPECT().IOCounters(true).Return([]gnet.IOCountersStat{
+		{Name: "lo", BytesRecv: 100},
+		{Name: "enp3s0", BytesRecv: 200},
+		{Name: "wg0", BytesRecv: 300},
+		{Name: "docker0", BytesRecv: 400},
+		{Name: "tap0", BytesRecv: 500},
+	}, nil)
+	net.EXPECT().Interfaces().Return([]gnet.InterfaceStat{
+		{Name: "lo", Flags: []string{"up", "loopback"}},
+		{Name: "enp3s0", Flags: []string{"up", "broadcast"}},
+		{Name: "wg0", Flags: []string{"up", "pointtopoint"}},
+		{Name: "docker0", Flags: []string{"up", "broadcast"}},
+		{Name: "tap0", Flags: []string{"broadcast"}},
+	}, nil)
+
+	g := NewGopsUtilWithProviders(nil, nil, nil, net, nil, nil, nil, nil, nil)
+
+	names := func(g *GopsUtil) []string {
+		rates, err := g.GetNetworkRates("")
+		require.NoError(t, err)
+		var result []string
+		for _, iface := range rates.Interfaces {
+			result = append(result, iface.Interface)
+		}
+		return result
+	}
+
+	assert.ElementsMatch(t, []string{"enp3s0", "wg0"}, names(g))
+
+	all, err := g.WithNetworkFilter(models.NetworkFilter{Preset: "all"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"enp3s0", "wg0", "docker0"}, names(all))
+
+	only, err := g.WithNetworkFilter(models.NetworkFilter{Include: []string{"wg*"}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"wg0"}, names(only))
+}
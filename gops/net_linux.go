@@ -2,14 +2,97 @@
 
 package gops
 
-import "strings"
+import (
+	"path/filepath"
 
-func matchesNetworkInterface(name string) bool {
-	prefixes := []string{"wlan", "wlo", "wlp", "eth", "eno", "enp", "ens", "lxc"}
-	for _, prefix := range prefixes {
-		if strings.HasPrefix(name, prefix) {
-			return true
-		}
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const (
+	netClassPath     = "/sys/class/net"
+	procWirelessPath = "/proc/net/wireless"
+)
+
+// ARPHRD_* values from include/uapi/linux/if_arp.h
+var arpHardwareTypes = map[int]string{
+	1:     "ethernet",
+	32:    "infiniband",
+	512:   "ppp",
+	519:   "wwan",
+	768:   "tunnel",
+	772:   "loopback",
+	776:   "tunnel",
+	65534: "tunnel",
+}
+
+func (self *GopsUtil) readNetworkType(name string) string {
+	return self.netInterfaceType(filepath.Join(netClassPath, name))
+}
+
+func (self *GopsUtil) readNetworkLink(name string) (*netLink, error) {
+	return self.readNetworkLinkFrom(netClassPath, name)
+}
+
+func (self *GopsUtil) readNetworkLinkFrom(root, name string) (*netLink, error) {
+	dir := filepath.Join(root, name)
+	if _, err := self.fs.Stat(dir); err != nil {
+		return nil, err
+	}
+
+	link := &netLink{
+		OperState: self.readSysfsString(filepath.Join(dir, "operstate")),
+		Carrier:   self.readSysfsString(filepath.Join(dir, "carrier")) == "1",
+		Duplex:    self.readSysfsString(filepath.Join(dir, "duplex")),
+		Type:      self.netInterfaceType(dir),
+	}
+
+	// speed reads -1 or fails with EINVAL when there is no link
+	if speed := self.readSysfsInt(filepath.Join(dir, "speed"), 0); speed > 0 {
+		link.Speed = speed
+	}
+	if link.Duplex == "unknown" {
+		link.Duplex = ""
+	}
+
+	if driver, err := self.fs.Readlink(filepath.Join(dir, "device", "driver")); err == nil {
+		link.Driver = filepath.Base(driver)
+	}
+
+	return link, nil
+}
+
+func (self *GopsUtil) netInterfaceType(dir string) string {
+	switch {
+	case self.sysfsExists(filepath.Join(dir, "wireless")), self.sysfsExists(filepath.Join(dir, "phy80211")):
+		return "wireless"
+	case self.sysfsExists(filepath.Join(dir, "bridge")):
+		return "bridge"
+	case self.sysfsExists(filepath.Join(dir, "bonding")):
+		return "bond"
+	case self.sysfsExists(filepath.Join(dir, "tun_flags")):
+		return "tun"
+	}
+
+	kind, ok := arpHardwareTypes[self.readSysfsInt(filepath.Join(dir, "type"), -1)]
+	if !ok {
+		return ""
+	}
+	// veth, macvlan and friends are ARPHRD_ETHER without a backing device
+	if kind == "ethernet" && !self.sysfsExists(filepath.Join(dir, "device")) {
+		return "virtual"
+	}
+	return kind
+}
+
+func (self *GopsUtil) sysfsExists(path string) bool {
+	_, err := self.fs.Stat(path)
+	return err == nil
+}
+
+func (self *GopsUtil) readWirelessStats() (map[string]*models.WirelessInfo, error) {
+	data, err := self.fs.ReadFile(procWirelessPath)
+	if err != nil {
+		return nil, err
 	}
-	return false
+	return parseNetWireless(data), nil
 }
@@ -6,81 +6,54 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestMatchesNetworkInterface(t *testing.T) {
-	tests := []struct {
-		name     string
-		iface    string
-		expected bool
-	}{
-		{"wireless wlan", "wlan0", true},
-		{"wireless wlo", "wlo1", true},
-		{"wireless wlp", "wlp2s0", true},
-		{"ethernet eth", "eth0", true},
-		{"ethernet eno", "eno1", true},
-		{"ethernet enp", "enp3s0", true},
-		{"ethernet ens", "ens33", true},
-		{"lxc bridge", "lxcbr0", true},
-		{"loopback", "lo", false},
-		{"docker", "docker0", false},
-		{"virbr", "virbr0", false},
-		{"veth", "veth123abc", false},
-		{"tun", "tun0", false},
-		{"tap", "tap0", false},
-		{"empty", "", false},
-		{"random", "foobar", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := matchesNetworkInterface(tt.iface)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
-func TestMatchesNetworkInterfaceRealWorld(t *testing.T) {
-	validInterfaces := []string{
-		"eth0", "eth1",
-		"eno1", "eno2",
-		"enp0s3", "enp0s25",
-		"ens33", "ens192",
-		"wlan0", "wlan1",
-		"wlo1",
-		"wlp3s0", "wlp4s0",
-		"lxcbr0", "lxcbr1",
-	}
-
-	for _, iface := range validInterfaces {
-		t.Run(iface, func(t *testing.T) {
-			assert.True(t, matchesNetworkInterface(iface), "%s should match", iface)
-		})
-	}
-
-	invalidInterfaces := []string{
-		"lo",
-		"docker0", "docker1",
-		"virbr0", "virbr1",
-		"veth0", "vethab123cd",
-		"br-1234567890ab",
-		"tun0", "tap0",
-	}
-
-	for _, iface := range invalidInterfaces {
-		t.Run(iface, func(t *testing.T) {
-			assert.False(t, matchesNetworkInterface(iface), "%s should not match", iface)
-		})
-	}
-}
-
-func BenchmarkMatchesNetworkInterface(b *testing.B) {
-	testCases := []string{"eth0", "wlan0", "docker0", "lo", "enp3s0"}
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, tc := range testCases {
-			matchesNetworkInterface(tc)
-		}
-	}
+func TestReadNetworkLinkFrom(t *testing.T) {
+	gops, fsys := newFixtureGops(map[string]string{
+		"/sys/class/net/enp3s0/operstate":      "up",
+		"/sys/class/net/enp3s0/carrier":        "1",
+		"/sys/class/net/enp3s0/speed":          "1000",
+		"/sys/class/net/enp3s0/duplex":         "full",
+		"/sys/class/net/enp3s0/type":           "1",
+		"/sys/class/net/wlp2s0/operstate":      "dormant",
+		"/sys/class/net/wlp2s0/carrier":        "0",
+		"/sys/class/net/wlp2s0/speed":          "-1",
+		"/sys/class/net/wlp2s0/type":           "1",
+		"/sys/class/net/wlp2s0/wireless/.keep": "",
+		"/sys/class/net/veth1a2b/operstate":    "up",
+		"/sys/class/net/veth1a2b/type":         "1",
+		"/sys/class/net/veth1a2b/duplex":       "unknown",
+		"/sys/class/net/enp3s0/device/vendor":  "0x10ec",
+		"/sys/class/net/wlp2s0/device/vendor":  "0x8086",
+		"/sys/class/net/wlp2s0/phy80211/name":  "phy0",
+		"/sys/bus/pci/drivers/r8169/bind":      "",
+	})
+	fsys.symlink("/sys/class/net/enp3s0/device/driver", "../../../../bus/pci/drivers/r8169")
+
+	wired, err := gops.readNetworkLinkFrom(netClassPath, "enp3s0")
+	require.NoError(t, err)
+	assert.Equal(t, &netLink{
+		OperState: "up",
+		Carrier:   true,
+		Speed:     1000,
+		Duplex:    "full",
+		Driver:    "r8169",
+		Type:      "ethernet",
+	}, wired)
+
+	wifi, err := gops.readNetworkLinkFrom(netClassPath, "wlp2s0")
+	require.NoError(t, err)
+	assert.Equal(t, "wireless", wifi.Type)
+	assert.Equal(t, "dormant", wifi.OperState)
+	assert.False(t, wifi.Carrier)
+	assert.Zero(t, wifi.Speed)
+
+	veth, err := gops.readNetworkLinkFrom(netClassPath, "veth1a2b")
+	require.NoError(t, err)
+	assert.Equal(t, "virtual", veth.Type)
+	assert.Empty(t, veth.Duplex)
+
+	_, err = gops.readNetworkLinkFrom(netClassPath, "missing0")
+	assert.Error(t, err)
 }
@@ -0,0 +1,42 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	gnet "github.com/shirou/gopsutil/v4/net"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNetWireless(t *testing.T) {
+	data := []byte(`Inter-| sta-|   Quality        |   Discarded packets               | Missed | WE
+ face | tus | link level noise |  nwid  crypt   frag  retry   misc | beacon | 22
+wlp2s0: 0000   54.  -56.  -256        0      0      0      0      0        0
+ wlan1: 0000   70.  -40.  -95.        0      0      0      0      0        0
+`)
+
+	stats := parseNetWireless(data)
+
+	assert.Len(t, stats, 2)
+	assert.Equal(t, &models.WirelessInfo{Quality: 54, Signal: -56}, stats["wlp2s0"])
+	assert.Equal(t, &models.WirelessInfo{Quality: 70, Signal: -40, Noise: -95}, stats["wlan1"])
+}
+
+func TestApplyInterfaceStat(t *testing.T) {
+	info := &models.NetworkInfo{Name: "enp3s0"}
+	applyInterfaceStat(info, gnet.InterfaceStat{
+		Name:         "enp3s0",
+		MTU:          1500,
+		HardwareAddr: "a8:a1:59:12:34:56",
+		Addrs: gnet.InterfaceAddrList{
+			{Addr: "192.168.1.20/24"},
+			{Addr: "fe80::aaa1:59ff:fe12:3456/64"},
+			{Addr: "garbage"},
+		},
+	})
+
+	assert.Equal(t, 1500, info.MTU)
+	assert.Equal(t, "a8:a1:59:12:34:56", info.MAC)
+	assert.Equal(t, []string{"192.168.1.20/24"}, info.IPv4)
+	assert.Equal(t, []string{"fe80::aaa1:59ff:fe12:3456/64"}, info.IPv6)
+}
@@ -25,7 +25,7 @@ func (self *GopsUtil) GetNetworkRates(cursorStr string) (*models.NetworkRateResp
 
 	currentStats := make(map[string]net.IOCountersStat)
 	for _, n := range netIO {
-		if isUsableNetworkInterface(n.Name, ifaceIndex) {
+		if self.isUsableNetworkInterface(n.Name, ifaceIndex) {
 			currentStats[n.Name] = n
 		}
 	}
@@ -41,16 +41,7 @@ func (self *GopsUtil) GetNetworkRates(cursorStr string) (*models.NetworkRateResp
 			if timeDiff > 0 {
 				for name, current := range currentStats {
 					if prev, exists := cursor.IOStats[name]; exists {
-						rxRate := float64(current.BytesRecv-prev.BytesRecv) / timeDiff
-						txRate := float64(current.BytesSent-prev.BytesSent) / timeDiff
-
-						interfaces = append(interfaces, &models.NetworkRateInfo{
-							Interface: name,
-							RxRate:    rxRate,
-							TxRate:    txRate,
-							RxTotal:   current.BytesRecv,
-							TxTotal:   current.BytesSent,
-						})
+						interfaces = append(interfaces, networkRateInfo(name, current, prev, timeDiff))
 					}
 				}
 			}
@@ -87,6 +78,22 @@ func (self *GopsUtil) GetNetworkRates(cursorStr string) (*models.NetworkRateResp
 	}, nil
 }
 
+func networkRateInfo(name string, current, prev net.IOCountersStat, timeDiff float64) *models.NetworkRateInfo {
+	return &models.NetworkRateInfo{
+		Interface:    name,
+		RxRate:       counterRate(current.BytesRecv, prev.BytesRecv, timeDiff),
+		TxRate:       counterRate(current.BytesSent, prev.BytesSent, timeDiff),
+		RxTotal:      current.BytesRecv,
+		TxTotal:      current.BytesSent,
+		RxPacketRate: counterRate(current.PacketsRecv, prev.PacketsRecv, timeDiff),
+		TxPacketRate: counterRate(current.PacketsSent, prev.PacketsSent, timeDiff),
+		RxErrorRate:  counterRate(current.Errin, prev.Errin, timeDiff),
+		TxErrorRate:  counterRate(current.Errout, prev.Errout, timeDiff),
+		RxDropRate:   counterRate(current.Dropin, prev.Dropin, timeDiff),
+		TxDropRate:   counterRate(current.Dropout, prev.Dropout, timeDiff),
+	}
+}
+
 func encodeNetworkRateCursor(cursor NetworkRateCursor) (string, error) {
 	jsonData, err := json.Marshal(cursor)
 	if err != nil {
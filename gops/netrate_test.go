@@ -155,6 +155,45 @@ func TestNetworkRateCalculations(t *testing.T) {
 	}
 }
 
+func TestNetworkRateInfo(t *testing.T) {
+	prev := net.IOCountersStat{
+		BytesRecv: 1000, BytesSent: 500,
+		PacketsRecv: 100, PacketsSent: 50,
+		Errin: 2, Errout: 0,
+		Dropin: 10, Dropout: 1,
+	}
+	current := net.IOCountersStat{
+		BytesRecv: 3000, BytesSent: 900,
+		PacketsRecv: 140, PacketsSent: 70,
+		Errin: 6, Errout: 0,
+		Dropin: 30, Dropout: 1,
+	}
+
+	info := networkRateInfo("wlp2s0", current, prev, 2)
+
+	assert.Equal(t, "wlp2s0", info.Interface)
+	assert.InDelta(t, 1000.0, info.RxRate, 0.01)
+	assert.InDelta(t, 200.0, info.TxRate, 0.01)
+	assert.Equal(t, uint64(3000), info.RxTotal)
+	assert.InDelta(t, 20.0, info.RxPacketRate, 0.01)
+	assert.InDelta(t, 10.0, info.TxPacketRate, 0.01)
+	assert.InDelta(t, 2.0, info.RxErrorRate, 0.01)
+	assert.Zero(t, info.TxErrorRate)
+	assert.InDelta(t, 10.0, info.RxDropRate, 0.01)
+	assert.Zero(t, info.TxDropRate)
+}
+
+func TestNetworkRateInfoCounterReset(t *testing.T) {
+	prev := net.IOCountersStat{BytesRecv: 5000, PacketsRecv: 80, Dropin: 9}
+	current := net.IOCountersStat{BytesRecv: 100, PacketsRecv: 2, Dropin: 0}
+
+	info := networkRateInfo("eth0", current, prev, 1)
+
+	assert.Zero(t, info.RxRate)
+	assert.Zero(t, info.RxPacketRate)
+	assert.Zero(t, info.RxDropRate)
+}
+
 func BenchmarkEncodeNetworkRateCursor(b *testing.B) {
 	cursor := NetworkRateCursor{
 		Timestamp: time.Now(),
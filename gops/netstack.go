@@ -0,0 +1,164 @@
+package gops
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+type NetStackCursor struct {
+	Timestamp time.Time               `json:"timestamp"`
+	Counters  models.NetStackCounters `json:"counters"`
+}
+
+func (self *GopsUtil) GetNetStack(cursorStr string) (*models.NetStackResponse, error) {
+	counters, err := self.readNetStackCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := time.Now()
+	response := &models.NetStackResponse{Counters: *counters}
+
+	if cursorStr != "" {
+		cursor, err := parseNetStackCursor(cursorStr)
+		if err == nil {
+			timeDiff := currentTime.Sub(cursor.Timestamp).Seconds()
+			if timeDiff > 0 {
+				applyNetStackRates(response, cursor.Counters, timeDiff)
+			}
+		}
+	}
+
+	newCursorStr, err := encodeNetStackCursor(NetStackCursor{
+		Timestamp: currentTime,
+		Counters:  *counters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response.Cursor = newCursorStr
+
+	return response, nil
+}
+
+func applyNetStackRates(response *models.NetStackResponse, prev models.NetStackCounters, timeDiff float64) {
+	curr := response.Counters
+	response.Rates = models.NetStackRates{
+		TcpOutSegs:          counterRate(curr.TcpOutSegs, prev.TcpOutSegs, timeDiff),
+		TcpRetransSegs:      counterRate(curr.TcpRetransSegs, prev.TcpRetransSegs, timeDiff),
+		TcpEstabResets:      counterRate(curr.TcpEstabResets, prev.TcpEstabResets, timeDiff),
+		TcpOutRsts:          counterRate(curr.TcpOutRsts, prev.TcpOutRsts, timeDiff),
+		TcpAttemptFails:     counterRate(curr.TcpAttemptFails, prev.TcpAttemptFails, timeDiff),
+		TcpInErrs:           counterRate(curr.TcpInErrs, prev.TcpInErrs, timeDiff),
+		ListenOverflows:     counterRate(curr.ListenOverflows, prev.ListenOverflows, timeDiff),
+		ListenDrops:         counterRate(curr.ListenDrops, prev.ListenDrops, timeDiff),
+		UdpInErrors:         counterRate(curr.UdpInErrors, prev.UdpInErrors, timeDiff),
+		UdpRcvbufErrors:     counterRate(curr.UdpRcvbufErrors, prev.UdpRcvbufErrors, timeDiff),
+		UdpSndbufErrors:     counterRate(curr.UdpSndbufErrors, prev.UdpSndbufErrors, timeDiff),
+		UdpNoPorts:          counterRate(curr.UdpNoPorts, prev.UdpNoPorts, timeDiff),
+		IcmpInDestUnreachs:  counterRate(curr.IcmpInDestUnreachs, prev.IcmpInDestUnreachs, timeDiff),
+		IcmpOutDestUnreachs: counterRate(curr.IcmpOutDestUnreachs, prev.IcmpOutDestUnreachs, timeDiff),
+		SyncookiesSent:      counterRate(curr.SyncookiesSent, prev.SyncookiesSent, timeDiff),
+		SyncookiesRecv:      counterRate(curr.SyncookiesRecv, prev.SyncookiesRecv, timeDiff),
+		SyncookiesFailed:    counterRate(curr.SyncookiesFailed, prev.SyncookiesFailed, timeDiff),
+	}
+
+	// Share of outgoing segments that were retransmissions in the interval
+	if response.Rates.TcpOutSegs > 0 {
+		response.RetransmitPercent = response.Rates.TcpRetransSegs / response.Rates.TcpOutSegs * 100
+		if response.RetransmitPercent > 100 {
+			response.RetransmitPercent = 100
+		}
+	}
+}
+
+// parseProcNetPairs reads the header/value line pairs used by /proc/net/snmp
+// and /proc/net/netstat into nstat-style keys such as "TcpRetransSegs".
+func parseProcNetPairs(content string, values map[string]uint64) {
+	lines := strings.Split(content, "\n")
+	for i := 0; i+1 < len(lines); i += 2 {
+		header := strings.Fields(lines[i])
+		data := strings.Fields(lines[i+1])
+		if len(header) < 2 || len(header) != len(data) || header[0] != data[0] {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(header[0], ":")
+		for j := 1; j < len(header); j++ {
+			value, err := strconv.ParseUint(data[j], 10, 64)
+			if err != nil {
+				continue
+			}
+			values[prefix+header[j]] = value
+		}
+	}
+}
+
+// parseProcNetSnmp6 reads the "Key value" lines of /proc/net/snmp6.
+func parseProcNetSnmp6(content string, values map[string]uint64) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		values[fields[0]] = value
+	}
+}
+
+// netStackCountersFrom picks the health counters out of the merged values,
+// folding the IPv6 UDP and ICMP counters into their IPv4 totals.
+func netStackCountersFrom(values map[string]uint64) (*models.NetStackCounters, error) {
+	if _, ok := values["TcpOutSegs"]; !ok {
+		return nil, fmt.Errorf("no tcp counters found")
+	}
+
+	return &models.NetStackCounters{
+		TcpOutSegs:          values["TcpOutSegs"],
+		TcpRetransSegs:      values["TcpRetransSegs"],
+		TcpEstabResets:      values["TcpEstabResets"],
+		TcpOutRsts:          values["TcpOutRsts"],
+		TcpAttemptFails:     values["TcpAttemptFails"],
+		TcpInErrs:           values["TcpInErrs"],
+		ListenOverflows:     values["TcpExtListenOverflows"],
+		ListenDrops:         values["TcpExtListenDrops"],
+		UdpInErrors:         values["UdpInErrors"] + values["Udp6InErrors"],
+		UdpRcvbufErrors:     values["UdpRcvbufErrors"] + values["Udp6RcvbufErrors"],
+		UdpSndbufErrors:     values["UdpSndbufErrors"] + values["Udp6SndbufErrors"],
+		UdpNoPorts:          values["UdpNoPorts"] + values["Udp6NoPorts"],
+		IcmpInDestUnreachs:  values["IcmpInDestUnreachs"] + values["Icmp6InDestUnreachs"],
+		IcmpOutDestUnreachs: values["IcmpOutDestUnreachs"] + values["Icmp6OutDestUnreachs"],
+		SyncookiesSent:      values["TcpExtSyncookiesSent"],
+		SyncookiesRecv:      values["TcpExtSyncookiesRecv"],
+		SyncookiesFailed:    values["TcpExtSyncookiesFailed"],
+	}, nil
+}
+
+func encodeNetStackCursor(cursor NetStackCursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parseNetStackCursor(cursorStr string) (NetStackCursor, error) {
+	var cursor NetStackCursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
+}
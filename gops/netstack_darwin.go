@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readNetStackCounters() (*models.NetStackCounters, error) {
+	return nil, fmt.Errorf("netstack is not supported on darwin")
+}
@@ -0,0 +1,34 @@
+//go:build linux
+
+package gops
+
+import (
+	"path/filepath"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readNetStackCounters() (*models.NetStackCounters, error) {
+	return self.readNetStackCountersFrom("/proc")
+}
+
+// readNetStackCountersFrom merges snmp, netstat and snmp6. Only snmp is
+// required; snmp6 is absent when IPv6 is disabled.
+func (self *GopsUtil) readNetStackCountersFrom(procRoot string) (*models.NetStackCounters, error) {
+	data, err := self.fs.ReadFile(filepath.Join(procRoot, "net", "snmp"))
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]uint64)
+	parseProcNetPairs(string(data), values)
+
+	if data, err := self.fs.ReadFile(filepath.Join(procRoot, "net", "netstat")); err == nil {
+		parseProcNetPairs(string(data), values)
+	}
+	if data, err := self.fs.ReadFile(filepath.Join(procRoot, "net", "snmp6")); err == nil {
+		parseProcNetSnmp6(string(data), values)
+	}
+
+	return netStackCountersFrom(values)
+}
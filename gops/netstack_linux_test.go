@@ -0,0 +1,41 @@
+//go:build linux
+
+package gops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadNetStackCountersFrom(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/proc/net/snmp":    sampleProcNetSnmp,
+		"/proc/net/netstat": sampleProcNetNetstat,
+		"/proc/net/snmp6":   sampleProcNetSnmp6,
+	})
+
+	c, err := gops.readNetStackCountersFrom("/proc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(41), c.TcpRetransSegs)
+	assert.Equal(t, uint64(19), c.ListenDrops)
+	assert.Equal(t, uint64(15), c.UdpInErrors)
+}
+
+func TestReadNetStackCountersFromWithoutIPv6(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/proc/net/snmp": sampleProcNetSnmp,
+	})
+
+	c, err := gops.readNetStackCountersFrom("/proc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), c.UdpInErrors)
+	assert.Equal(t, uint64(0), c.ListenOverflows)
+}
+
+func TestReadNetStackCountersFromMissing(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	_, err := gops.readNetStackCountersFrom("/proc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleProcNetSnmp = `Ip: Forwarding DefaultTTL InReceives
+Ip: 2 64 12600
+Icmp: InMsgs InErrors InDestUnreachs OutMsgs OutDestUnreachs
+Icmp: 12 0 7 9 4
+Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens PassiveOpens AttemptFails EstabResets CurrEstab InSegs OutSegs RetransSegs InErrs OutRsts InCsumErrors
+Tcp: 1 200 120000 -1 172 122 3 100 2 12500 13007 41 2 29 0
+Udp: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti MemErrors
+Udp: 100 6 12 100 11 1 0 0 0
+UdpLite: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti MemErrors
+UdpLite: 0 0 99 0 99 0 0 0 0
+`
+
+const sampleProcNetNetstat = `TcpExt: SyncookiesSent SyncookiesRecv SyncookiesFailed EmbryonicRsts ListenOverflows ListenDrops
+TcpExt: 5 4 1 0 17 19
+IpExt: InNoRoutes InOctets
+IpExt: 0 121313357
+`
+
+const sampleProcNetSnmp6 = `Ip6InReceives                   	5
+Icmp6InDestUnreachs             	2
+Icmp6OutDestUnreachs            	1
+Udp6InErrors                    	3
+Udp6NoPorts                     	1
+Udp6RcvbufErrors                	2
+UdpLite6InErrors                	50
+`
+
+func TestParseProcNetPairs(t *testing.T) {
+	values := make(map[string]uint64)
+	parseProcNetPairs(sampleProcNetSnmp, values)
+	parseProcNetPairs(sampleProcNetNetstat, values)
+
+	assert.Equal(t, uint64(13007), values["TcpOutSegs"])
+	assert.Equal(t, uint64(41), values["TcpRetransSegs"])
+	assert.Equal(t, uint64(99), values["UdpLiteInErrors"])
+	assert.Equal(t, uint64(17), values["TcpExtListenOverflows"])
+	assert.Equal(t, uint64(121313357), values["IpExtInOctets"])
+	_, ok := values["TcpMaxConn"]
+	assert.False(t, ok, "negative values are skipped")
+}
+
+func TestParseProcNetPairsMismatchedLines(t *testing.T) {
+	values := make(map[string]uint64)
+	parseProcNetPairs("Tcp: InSegs OutSegs\nUdp: 1 2\n", values)
+	assert.Empty(t, values)
+}
+
+func TestNetStackCountersFrom(t *testing.T) {
+	values := make(map[string]uint64)
+	parseProcNetPairs(sampleProcNetSnmp, values)
+	parseProcNetPairs(sampleProcNetNetstat, values)
+	parseProcNetSnmp6(sampleProcNetSnmp6, values)
+
+	c, err := netStackCountersFrom(values)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(13007), c.TcpOutSegs)
+	assert.Equal(t, uint64(41), c.TcpRetransSegs)
+	assert.Equal(t, uint64(100), c.TcpEstabResets)
+	assert.Equal(t, uint64(29), c.TcpOutRsts)
+	assert.Equal(t, uint64(3), c.TcpAttemptFails)
+	assert.Equal(t, uint64(2), c.TcpInErrs)
+	assert.Equal(t, uint64(17), c.ListenOverflows)
+	assert.Equal(t, uint64(19), c.ListenDrops)
+	assert.Equal(t, uint64(15), c.UdpInErrors)
+	assert.Equal(t, uint64(13), c.UdpRcvbufErrors)
+	assert.Equal(t, uint64(1), c.UdpSndbufErrors)
+	assert.Equal(t, uint64(7), c.UdpNoPorts)
+	assert.Equal(t, uint64(9), c.IcmpInDestUnreachs)
+	assert.Equal(t, uint64(5), c.IcmpOutDestUnreachs)
+	assert.Equal(t, uint64(5), c.SyncookiesSent)
+	assert.Equal(t, uint64(4), c.SyncookiesRecv)
+	assert.Equal(t, uint64(1), c.SyncookiesFailed)
+}
+
+func TestNetStackCountersFromEmpty(t *testing.T) {
+	_, err := netStackCountersFrom(map[string]uint64{})
+	assert.Error(t, err)
+}
+
+func TestApplyNetStackRates(t *testing.T) {
+	prev := models.NetStackCounters{TcpOutSegs: 1000, TcpRetransSegs: 10, ListenOverflows: 2, UdpRcvbufErrors: 5}
+	resp := &models.NetStackResponse{Counters: models.NetStackCounters{
+		TcpOutSegs: 3000, TcpRetransSegs: 50, ListenOverflows: 8, UdpRcvbufErrors: 25,
+	}}
+
+	applyNetStackRates(resp, prev, 2)
+
+	assert.Equal(t, 1000.0, resp.Rates.TcpOutSegs)
+	assert.Equal(t, 20.0, resp.Rates.TcpRetransSegs)
+	assert.Equal(t, 3.0, resp.Rates.ListenOverflows)
+	assert.Equal(t, 10.0, resp.Rates.UdpRcvbufErrors)
+	assert.InDelta(t, 2.0, resp.RetransmitPercent, 0.001)
+}
+
+func TestApplyNetStackRatesCounterReset(t *testing.T) {
+	prev := models.NetStackCounters{TcpOutSegs: 5000, TcpRetransSegs: 100}
+	resp := &models.NetStackResponse{Counters: models.NetStackCounters{TcpOutSegs: 100, TcpRetransSegs: 1}}
+
+	applyNetStackRates(resp, prev, 1)
+
+	assert.Equal(t, 0.0, resp.Rates.TcpOutSegs)
+	assert.Equal(t, 0.0, resp.Rates.TcpRetransSegs)
+	assert.Equal(t, 0.0, resp.RetransmitPercent)
+}
+
+func TestNetStackCursorRoundTrip(t *testing.T) {
+	cursor := NetStackCursor{Counters: models.NetStackCounters{TcpOutSegs: 42, ListenDrops: 3}}
+
+	encoded, err := encodeNetStackCursor(cursor)
+	require.NoError(t, err)
+
+	decoded, err := parseNetStackCursor(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Counters, decoded.Counters)
+
+	_, err = parseNetStackCursor("not-base64!")
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package gops
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+type NUMACursor struct {
+	Timestamp time.Time            `json:"timestamp"`
+	Counters  map[int]numaCounters `json:"counters"`
+}
+
+type numaCounters struct {
+	Hit     uint64 `json:"hit"`
+	Miss    uint64 `json:"miss"`
+	Foreign uint64 `json:"foreign"`
+}
+
+func (self *GopsUtil) GetNUMA(cursorStr string) (*models.NUMAResponse, error) {
+	nodes, err := self.readNUMANodeStats()
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := time.Now()
+	currentCounters := make(map[int]numaCounters, len(nodes))
+	for _, node := range nodes {
+		currentCounters[node.Node] = numaCounters{Hit: node.NumaHit, Miss: node.NumaMiss, Foreign: node.NumaForeign}
+		node.MissPercent = numaMissPercent(node.NumaHit, node.NumaMiss)
+	}
+
+	if cursorStr != "" {
+		cursor, err := parseNUMACursor(cursorStr)
+		if err == nil {
+			timeDiff := currentTime.Sub(cursor.Timestamp).Seconds()
+			if timeDiff > 0 {
+				for _, node := range nodes {
+					if prev, ok := cursor.Counters[node.Node]; ok {
+						applyNUMARates(node, prev, timeDiff)
+					}
+				}
+			}
+		}
+	}
+
+	newCursorStr, err := encodeNUMACursor(NUMACursor{
+		Timestamp: currentTime,
+		Counters:  currentCounters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NUMAResponse{
+		Nodes:  nodes,
+		Cursor: newCursorStr,
+	}, nil
+}
+
+// applyNUMARates turns the counter deltas since the cursor into per-second
+// rates. With a cursor, MissPercent covers only the sampled interval.
+func applyNUMARates(node *models.NUMANodeInfo, prev numaCounters, timeDiff float64) {
+	node.HitRate = counterRate(node.NumaHit, prev.Hit, timeDiff)
+	node.MissRate = counterRate(node.NumaMiss, prev.Miss, timeDiff)
+	node.ForeignRate = counterRate(node.NumaForeign, prev.Foreign, timeDiff)
+	if node.NumaHit >= prev.Hit && node.NumaMiss >= prev.Miss {
+		node.MissPercent = numaMissPercent(node.NumaHit-prev.Hit, node.NumaMiss-prev.Miss)
+	}
+}
+
+func numaMissPercent(hit, miss uint64) float64 {
+	if hit+miss == 0 {
+		return 0
+	}
+	return float64(miss) / float64(hit+miss) * 100
+}
+
+// parseNodeMeminfo parses a node's meminfo, whose lines carry a "Node N"
+// prefix ahead of the usual /proc/meminfo key. Values stay in kB.
+func parseNodeMeminfo(content string) map[string]uint64 {
+	fields := make(map[string]uint64)
+	for _, line := range strings.Split(content, "\n") {
+		parts := strings.Fields(line)
+		if len(parts) < 4 || parts[0] != "Node" {
+			continue
+		}
+		value, err := strconv.ParseUint(parts[3], 10, 64)
+		if err != nil {
+			continue
+		}
+		fields[strings.TrimSuffix(parts[2], ":")] = value
+	}
+	return fields
+}
+
+func applyNodeMeminfo(node *models.NUMANodeInfo, content string) {
+	fields := parseNodeMeminfo(content)
+	node.Total = fields["MemTotal"]
+	node.Free = fields["MemFree"]
+	node.Used = fields["MemUsed"]
+	if node.Used == 0 && node.Total > node.Free {
+		node.Used = node.Total - node.Free
+	}
+	node.FilePages = fields["FilePages"]
+	node.AnonPages = fields["AnonPages"]
+	if node.Total > 0 {
+		node.UsedPercent = float64(node.Used) / float64(node.Total) * 100
+	}
+}
+
+func applyNUMAStat(node *models.NUMANodeInfo, content string) {
+	for _, line := range strings.Split(content, "\n") {
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch parts[0] {
+		case "numa_hit":
+			node.NumaHit = value
+		case "numa_miss":
+			node.NumaMiss = value
+		case "numa_foreign":
+			node.NumaForeign = value
+		case "interleave_hit":
+			node.InterleaveHit = value
+		case "local_node":
+			node.LocalNode = value
+		case "other_node":
+			node.OtherNode = value
+		}
+	}
+}
+
+func encodeNUMACursor(cursor NUMACursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parseNUMACursor(cursorStr string) (NUMACursor, error) {
+	var cursor NUMACursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
+}
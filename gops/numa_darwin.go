@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readNUMANodeStats() ([]*models.NUMANodeInfo, error) {
+	return nil, fmt.Errorf("NUMA statistics are not supported on darwin")
+}
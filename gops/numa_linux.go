@@ -0,0 +1,57 @@
+//go:build linux
+
+package gops
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readNUMANodeStats() ([]*models.NUMANodeInfo, error) {
+	return self.readNUMANodeStatsFrom(nodeSysfsPath)
+}
+
+func (self *GopsUtil) readNUMANodeStatsFrom(nodeRoot string) ([]*models.NUMANodeInfo, error) {
+	entries, err := self.fs.ReadDir(nodeRoot)
+	if err != nil {
+		return nil, fmt.Errorf("NUMA information is unavailable: %w", err)
+	}
+
+	var nodes []*models.NUMANodeInfo
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "node") {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(entry.Name(), "node"))
+		if err != nil {
+			continue
+		}
+		dir := filepath.Join(nodeRoot, entry.Name())
+
+		meminfo, err := self.fs.ReadFile(filepath.Join(dir, "meminfo"))
+		if err != nil {
+			continue
+		}
+
+		node := &models.NUMANodeInfo{Node: id}
+		node.CPUs, _ = parseCPUList(self.readSysfsString(filepath.Join(dir, "cpulist")))
+		applyNodeMeminfo(node, string(meminfo))
+		if numastat, err := self.fs.ReadFile(filepath.Join(dir, "numastat")); err == nil {
+			applyNUMAStat(node, string(numastat))
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no NUMA nodes found in %s", nodeRoot)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return nodes, nil
+}
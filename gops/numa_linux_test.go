@@ -0,0 +1,41 @@
+//go:build linux
+
+package gops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadNUMANodeStatsFrom(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/devices/system/node/node1/cpulist":  "8-15",
+		"/sys/devices/system/node/node1/meminfo":  "Node 1 MemTotal: 2000 kB\nNode 1 MemFree: 500 kB\nNode 1 MemUsed: 1500 kB\n",
+		"/sys/devices/system/node/node1/numastat": "numa_hit 10\nnuma_miss 5\n",
+		"/sys/devices/system/node/node0/cpulist":  "0-7",
+		"/sys/devices/system/node/node0/meminfo":  "Node 0 MemTotal: 1000 kB\nNode 0 MemFree: 800 kB\nNode 0 MemUsed: 200 kB\n",
+		"/sys/devices/system/node/possible":       "0-1",
+	})
+
+	nodes, err := gops.readNUMANodeStats()
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	assert.Equal(t, 0, nodes[0].Node)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, nodes[0].CPUs)
+	assert.Equal(t, uint64(200), nodes[0].Used)
+	assert.Equal(t, uint64(0), nodes[0].NumaHit)
+
+	assert.Equal(t, 1, nodes[1].Node)
+	assert.Equal(t, uint64(1500), nodes[1].Used)
+	assert.Equal(t, uint64(5), nodes[1].NumaMiss)
+}
+
+func TestReadNUMANodeStatsFromMissing(t *testing.T) {
+	gops, fsys := newFixtureGops(nil)
+	fsys.mkdir(nodeSysfsPath)
+	_, err := gops.readNUMANodeStats()
+	assert.Error(t, err)
+}
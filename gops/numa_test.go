@@ -0,0 +1,63 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyNodeMeminfo(t *testing.T) {
+	node := &models.NUMANodeInfo{}
+	applyNodeMeminfo(node, `Node 1 MemTotal:       16000000 kB
+Node 1 MemFree:         4000000 kB
+Node 1 MemUsed:        12000000 kB
+Node 1 FilePages:       3000000 kB
+Node 1 AnonPages:       8000000 kB
+Node 1 HugePages_Total:     0
+`)
+
+	assert.Equal(t, uint64(16000000), node.Total)
+	assert.Equal(t, uint64(4000000), node.Free)
+	assert.Equal(t, uint64(12000000), node.Used)
+	assert.Equal(t, uint64(3000000), node.FilePages)
+	assert.Equal(t, uint64(8000000), node.AnonPages)
+	assert.InDelta(t, 75.0, node.UsedPercent, 0.001)
+}
+
+func TestApplyNodeMeminfoWithoutMemUsed(t *testing.T) {
+	node := &models.NUMANodeInfo{}
+	applyNodeMeminfo(node, "Node 0 MemTotal: 1000 kB\nNode 0 MemFree: 400 kB\n")
+	assert.Equal(t, uint64(600), node.Used)
+}
+
+func TestApplyNUMAStat(t *testing.T) {
+	node := &models.NUMANodeInfo{}
+	applyNUMAStat(node, "numa_hit 900\nnuma_miss 100\nnuma_foreign 7\ninterleave_hit 3\nlocal_node 850\nother_node 150\n")
+
+	assert.Equal(t, uint64(900), node.NumaHit)
+	assert.Equal(t, uint64(100), node.NumaMiss)
+	assert.Equal(t, uint64(7), node.NumaForeign)
+	assert.Equal(t, uint64(3), node.InterleaveHit)
+	assert.Equal(t, uint64(850), node.LocalNode)
+	assert.Equal(t, uint64(150), node.OtherNode)
+}
+
+func TestApplyNUMARates(t *testing.T) {
+	node := &models.NUMANodeInfo{NumaHit: 1300, NumaMiss: 200, NumaForeign: 50, MissPercent: 13.3}
+	applyNUMARates(node, numaCounters{Hit: 1000, Miss: 100, Foreign: 50}, 2)
+
+	assert.Equal(t, 150.0, node.HitRate)
+	assert.Equal(t, 50.0, node.MissRate)
+	assert.Equal(t, 0.0, node.ForeignRate)
+	assert.Equal(t, 25.0, node.MissPercent)
+}
+
+func TestNUMACursorRoundTrip(t *testing.T) {
+	encoded, err := encodeNUMACursor(NUMACursor{Counters: map[int]numaCounters{1: {Hit: 5, Miss: 2}}})
+	assert.NoError(t, err)
+
+	cursor, err := parseNUMACursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, numaCounters{Hit: 5, Miss: 2}, cursor.Counters[1])
+}
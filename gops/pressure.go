@@ -0,0 +1,141 @@
+package gops
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+var pressureResources = []string{"cpu", "memory", "io", "irq"}
+
+type PressureCursor struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Totals    map[string]uint64 `json:"totals"`
+}
+
+func (self *GopsUtil) GetPressure(cursorStr string) (*models.PressureResponse, error) {
+	resources, err := self.readPressureResources()
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := time.Now()
+	currentTotals := make(map[string]uint64)
+	for _, res := range resources {
+		if res.Some != nil {
+			currentTotals[res.Resource+".some"] = res.Some.Total
+		}
+		if res.Full != nil {
+			currentTotals[res.Resource+".full"] = res.Full.Total
+		}
+	}
+
+	// If we have a cursor, turn the stall-time deltas into a percentage of wall time
+	if cursorStr != "" {
+		cursor, err := parsePressureCursor(cursorStr)
+		if err == nil {
+			elapsedUs := float64(currentTime.Sub(cursor.Timestamp).Microseconds())
+			if elapsedUs > 0 {
+				for _, res := range resources {
+					applyPressureRate(res.Some, cursor.Totals, res.Resource+".some", elapsedUs)
+					applyPressureRate(res.Full, cursor.Totals, res.Resource+".full", elapsedUs)
+				}
+			}
+		}
+	}
+
+	newCursorStr, err := encodePressureCursor(PressureCursor{
+		Timestamp: currentTime,
+		Totals:    currentTotals,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PressureResponse{
+		Resources: resources,
+		Cursor:    newCursorStr,
+	}, nil
+}
+
+func applyPressureRate(stat *models.PressureStat, prevTotals map[string]uint64, key string, elapsedUs float64) {
+	if stat == nil {
+		return
+	}
+	prev, ok := prevTotals[key]
+	if !ok || stat.Total < prev {
+		return
+	}
+
+	percent := float64(stat.Total-prev) / elapsedUs * 100.0
+	if percent > 100 {
+		percent = 100
+	}
+	stat.StallPercent = percent
+}
+
+// parsePressureFile parses a /proc/pressure/<resource> file. The "full" line is
+// absent for cpu on older kernels and always zero for cpu at the system level.
+func parsePressureFile(content string) (some, full *models.PressureStat, err error) {
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		stat := &models.PressureStat{}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			switch key {
+			case "avg10":
+				stat.Avg10, _ = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stat.Avg60, _ = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stat.Avg300, _ = strconv.ParseFloat(value, 64)
+			case "total":
+				stat.Total, _ = strconv.ParseUint(value, 10, 64)
+			}
+		}
+
+		switch fields[0] {
+		case "some":
+			some = stat
+		case "full":
+			full = stat
+		}
+	}
+
+	if some == nil && full == nil {
+		return nil, nil, fmt.Errorf("no pressure lines found")
+	}
+	return some, full, nil
+}
+
+func encodePressureCursor(cursor PressureCursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parsePressureCursor(cursorStr string) (PressureCursor, error) {
+	var cursor PressureCursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
+}
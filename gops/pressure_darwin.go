@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readPressureResources() ([]*models.PressureInfo, error) {
+	return nil, fmt.Errorf("pressure stall information is not supported on darwin")
+}
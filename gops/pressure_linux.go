@@ -0,0 +1,39 @@
+//go:build linux
+
+package gops
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const pressurePath = "/proc/pressure"
+
+func (self *GopsUtil) readPressureResources() ([]*models.PressureInfo, error) {
+	var resources []*models.PressureInfo
+	for _, resource := range pressureResources {
+		contents, err := self.fs.ReadFile(filepath.Join(pressurePath, resource))
+		if err != nil {
+			// irq pressure needs CONFIG_IRQ_TIME_ACCOUNTING and a 6.1+ kernel
+			continue
+		}
+
+		some, full, err := parsePressureFile(string(contents))
+		if err != nil {
+			continue
+		}
+
+		resources = append(resources, &models.PressureInfo{
+			Resource: resource,
+			Some:     some,
+			Full:     full,
+		})
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("pressure stall information is unavailable (kernel built without CONFIG_PSI or psi=0)")
+	}
+	return resources, nil
+}
@@ -0,0 +1,79 @@
+package gops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePressureFile(t *testing.T) {
+	content := `some avg10=1.50 avg60=0.75 avg300=0.25 total=123456
+full avg10=0.10 avg60=0.05 avg300=0.01 total=6543
+`
+	some, full, err := parsePressureFile(content)
+	require.NoError(t, err)
+	require.NotNil(t, some)
+	require.NotNil(t, full)
+
+	assert.Equal(t, 1.50, some.Avg10)
+	assert.Equal(t, 0.75, some.Avg60)
+	assert.Equal(t, 0.25, some.Avg300)
+	assert.Equal(t, uint64(123456), some.Total)
+	assert.Equal(t, 0.10, full.Avg10)
+	assert.Equal(t, uint64(6543), full.Total)
+}
+
+func TestParsePressureFileSomeOnly(t *testing.T) {
+	some, full, err := parsePressureFile("some avg10=0.00 avg60=0.00 avg300=0.00 total=42\n")
+	require.NoError(t, err)
+	require.NotNil(t, some)
+	assert.Nil(t, full)
+	assert.Equal(t, uint64(42), some.Total)
+}
+
+func TestParsePressureFileEmpty(t *testing.T) {
+	_, _, err := parsePressureFile("")
+	assert.Error(t, err)
+}
+
+func TestApplyPressureRate(t *testing.T) {
+	prev := map[string]uint64{"io.some": 1000}
+
+	stat := &models.PressureStat{Total: 251000}
+	applyPressureRate(stat, prev, "io.some", 1000000)
+	assert.InDelta(t, 25.0, stat.StallPercent, 0.001)
+
+	capped := &models.PressureStat{Total: 5000000}
+	applyPressureRate(capped, prev, "io.some", 1000000)
+	assert.Equal(t, 100.0, capped.StallPercent)
+
+	missing := &models.PressureStat{Total: 5000}
+	applyPressureRate(missing, prev, "cpu.some", 1000000)
+	assert.Equal(t, 0.0, missing.StallPercent)
+
+	applyPressureRate(nil, prev, "io.some", 1000000)
+}
+
+func TestPressureCursorRoundTrip(t *testing.T) {
+	cursor := PressureCursor{
+		Timestamp: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Totals:    map[string]uint64{"cpu.some": 100, "io.full": 200},
+	}
+
+	encoded, err := encodePressureCursor(cursor)
+	require.NoError(t, err)
+	assert.NotEmpty(t, encoded)
+
+	decoded, err := parsePressureCursor(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Timestamp.Unix(), decoded.Timestamp.Unix())
+	assert.Equal(t, cursor.Totals, decoded.Totals)
+}
+
+func TestParsePressureCursorInvalid(t *testing.T) {
+	_, err := parsePressureCursor("not-valid-base64!!!")
+	assert.Error(t, err)
+}
@@ -7,6 +7,9 @@ import (
 	"reflect"
 	"runtime"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/AvengeMedia/dgop/models"
@@ -22,6 +25,76 @@ type processStaticInfo struct {
 	ExePath  string
 }
 
+// processStaticCache is shared by every scoped copy of a GopsUtil.
+type processStaticCache struct {
+	mu      sync.RWMutex
+	entries map[int32]processStaticInfo
+}
+
+func newProcessStaticCache() *processStaticCache {
+	return &processStaticCache{entries: make(map[int32]processStaticInfo)}
+}
+
+const (
+	procNetSourceSocket = "socket"
+	procNetSourceNetns  = "netns"
+)
+
+type processIOSample struct {
+	counters  *models.ProcessIOCounters
+	net       *models.ProcessNetCounters
+	timestamp int64
+}
+
+// processIOCache keeps the last disk and network I/O sample so processes outside
+// the previous cursor (e.g. beyond the limit) still get rates in long-running
+// callers, the same way gopsutil keeps the last CPU times per process.
+type processIOCache struct {
+	mu      sync.Mutex
+	samples map[int32]processIOSample
+}
+
+func newProcessIOCache() *processIOCache {
+	return &processIOCache{samples: make(map[int32]processIOSample)}
+}
+
+func (c *processIOCache) get(pid int32) (processIOSample, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sample, ok := c.samples[pid]
+	return sample, ok
+}
+
+// replace swaps in the latest samples, which also drops exited processes
+func (c *processIOCache) replace(samples map[int32]processIOSample) {
+	c.mu.Lock()
+	c.samples = samples
+	c.mu.Unlock()
+}
+
+func (c *processIOCache) empty() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.samples) == 0
+}
+
+// SetProcessNet turns on per-process network accounting for every sort order.
+// It is off by default because it walks every process's sockets; sorting by
+// net always enables it.
+func (self *GopsUtil) SetProcessNet(enabled bool) {
+	self.procNet = enabled
+}
+
+// WithProcessNet returns a clone of self with per-process network accounting set.
+func (self *GopsUtil) WithProcessNet(enabled bool) *GopsUtil {
+	if enabled == self.procNet {
+		return self
+	}
+	copied := self.clone()
+	copied.procNet = enabled
+	return copied
+}
+
 func (self *GopsUtil) GetProcesses(sortBy ProcSortBy, limit int, enableCPU bool, mergeChildren bool) (*models.ProcessListResponse, error) {
 	return self.GetProcessesWithCursor(sortBy, limit, enableCPU, "", mergeChildren)
 }
@@ -33,7 +106,20 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 	}
 
 	totalMem, _ := self.memProvider.VirtualMemory()
-	currentTime := time.Now().UnixMilli()
+
+	var memTotal uint64
+	if totalMem != nil {
+		memTotal = totalMem.Total
+	}
+	cpuCount := float64(runtime.NumCPU())
+	if cg := self.cgroupLimits(); cg != nil {
+		if cg.MemoryDir != "" {
+			memTotal = cg.memoryLimit(memTotal)
+		}
+		if cg.CPUDir != "" {
+			cpuCount = cg.cpuCount(runtime.NumCPU())
+		}
+	}
 
 	cursorMap := make(map[int32]*models.ProcessCursorData)
 	if cursor != "" {
@@ -48,15 +134,33 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 		}
 	}
 
-	if enableCPU && len(cursorMap) == 0 {
-		maxSample := 100
-		if len(procs) < maxSample {
-			maxSample = len(procs)
+	withNet := self.procNet || sortBy == SortByNet
+
+	if len(cursorMap) == 0 {
+		primed := false
+		if enableCPU {
+			maxSample := 100
+			if len(procs) < maxSample {
+				maxSample = len(procs)
+			}
+			for i := 0; i < maxSample; i++ {
+				_, _ = procs[i].CPUPercent()
+			}
+			primed = true
+		}
+		if (sortBy == SortByIO || sortBy == SortByNet) && self.procIO.empty() {
+			self.primeProcessIO(procs, withNet)
+			primed = true
 		}
-		for i := 0; i < maxSample; i++ {
-			_, _ = procs[i].CPUPercent()
+		if primed {
+			time.Sleep(200 * time.Millisecond)
 		}
-		time.Sleep(200 * time.Millisecond)
+	}
+
+	currentTime := time.Now().UnixMilli()
+	var netCounters map[int32]*models.ProcessNetCounters
+	if withNet {
+		netCounters = self.readProcessNetCounters()
 	}
 
 	self.pruneProcessStaticCache(procs)
@@ -66,6 +170,9 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 		info  *models.ProcessInfo
 	}
 
+	ioSamples := make(map[int32]processIOSample, len(procs))
+	var ioMu sync.Mutex
+
 	numWorkers := runtime.NumCPU()
 	if numWorkers > 8 {
 		numWorkers = 8
@@ -106,10 +213,10 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 					cpuPercent := 0.0
 					if enableCPU {
 						if cursorData, ok := cursorMap[p.Pid]; ok {
-							cpuPercent = calculateNormalizedProcessCPUPercentageWithCursor(cursorData, currentCPUTime, currentTime, runtime.NumCPU())
+							cpuPercent = calculateNormalizedProcessCPUPercentageWithCursor(cursorData, currentCPUTime, currentTime, cpuCount)
 						} else {
 							rawCpuPercent, _ := p.CPUPercent()
-							cpuPercent = rawCpuPercent / float64(runtime.NumCPU())
+							cpuPercent = rawCpuPercent / cpuCount
 						}
 					}
 
@@ -123,7 +230,7 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 
 					if memInfo != nil {
 						rssKB = memInfo.RSS / 1024
-						rssPercent = float32(memInfo.RSS) / float32(totalMem.Total) * 100
+						rssPercent = float32(memInfo.RSS) / float32(memTotal) * 100
 
 						memKB = rssKB
 						memPercent = rssPercent
@@ -132,32 +239,50 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 							pssDirty, err := getPssDirty(p.Pid)
 							if err == nil && pssDirty > 0 {
 								memKB = pssDirty
-								memPercent = float32(memKB*1024) / float32(totalMem.Total) * 100
+								memPercent = float32(memKB*1024) / float32(memTotal) * 100
 								memCalc = "pss_dirty"
 							}
 						}
 					}
 
-					results <- procResult{
-						index: idx,
-						info: &models.ProcessInfo{
-							PID:               p.Pid,
-							PPID:              staticInfo.PPID,
-							CPU:               cpuPercent,
-							PTicks:            currentCPUTime,
-							MemoryPercent:     memPercent,
-							MemoryKB:          memKB,
-							MemoryCalculation: memCalc,
-							RSSKB:             rssKB,
-							RSSPercent:        rssPercent,
-							PSSKB:             pssKB,
-							PSSPercent:        pssPercent,
-							Username:          staticInfo.Username,
-							Command:           staticInfo.Name,
-							FullCommand:       staticInfo.Cmdline,
-							ExecutablePath:    staticInfo.ExePath,
-						},
+					info := &models.ProcessInfo{
+						PID:               p.Pid,
+						PPID:              staticInfo.PPID,
+						CPU:               cpuPercent,
+						PTicks:            currentCPUTime,
+						MemoryPercent:     memPercent,
+						MemoryKB:          memKB,
+						MemoryCalculation: memCalc,
+						RSSKB:             rssKB,
+						RSSPercent:        rssPercent,
+						PSSKB:             pssKB,
+						PSSPercent:        pssPercent,
+						Username:          staticInfo.Username,
+						Command:           staticInfo.Name,
+						FullCommand:       staticInfo.Cmdline,
+						ExecutablePath:    staticInfo.ExePath,
 					}
+
+					if counters, err := self.readProcessIO(p.Pid); err == nil {
+						info.IO = counters
+					}
+					info.Net = netCounters[p.Pid]
+
+					if info.IO != nil || info.Net != nil {
+						if cursorData, ok := cursorMap[p.Pid]; ok && (cursorData.IO != nil || cursorData.Net != nil) {
+							applyProcessIORates(info, cursorData.IO, cursorData.Timestamp, currentTime)
+							applyProcessNetRates(info, cursorData.Net, cursorData.Timestamp, currentTime)
+						} else if sample, ok := self.procIO.get(p.Pid); ok {
+							applyProcessIORates(info, sample.counters, sample.timestamp, currentTime)
+							applyProcessNetRates(info, sample.net, sample.timestamp, currentTime)
+						}
+
+						ioMu.Lock()
+						ioSamples[p.Pid] = processIOSample{counters: info.IO, net: info.Net, timestamp: currentTime}
+						ioMu.Unlock()
+					}
+
+					results <- procResult{index: idx, info: info}
 				}()
 			}
 		}()
@@ -173,7 +298,9 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 		r := <-results
 		procList[r.index] = r.info
 	}
+	self.procIO.replace(ioSamples)
 
+	allProcs := procList
 	if mergeChildren {
 		procList = mergeProcessesByExecutable(procList)
 	}
@@ -195,6 +322,14 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 		sort.Slice(procList, func(i, j int) bool {
 			return procList[i].PID < procList[j].PID
 		})
+	case SortByIO:
+		sort.Slice(procList, func(i, j int) bool {
+			return procList[i].IOReadRate+procList[i].IOWriteRate > procList[j].IOReadRate+procList[j].IOWriteRate
+		})
+	case SortByNet:
+		sort.Slice(procList, func(i, j int) bool {
+			return procList[i].NetRxRate+procList[i].NetTxRate > procList[j].NetRxRate+procList[j].NetTxRate
+		})
 	default:
 		sort.Slice(procList, func(i, j int) bool {
 			return procList[i].CPU > procList[j].CPU
@@ -205,12 +340,21 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 		procList = procList[:limit]
 	}
 
-	cursorList := make([]models.ProcessCursorData, 0, len(procList))
-	for _, proc := range procList {
+	// Merged entries only carry the root's counters, so the cursor is built
+	// from every process that was folded into a returned entry
+	cursorProcs := procList
+	if mergeChildren {
+		cursorProcs = processesUnderRoots(allProcs, procList)
+	}
+
+	cursorList := make([]models.ProcessCursorData, 0, len(cursorProcs))
+	for _, proc := range cursorProcs {
 		cursorList = append(cursorList, models.ProcessCursorData{
 			PID:       proc.PID,
 			Ticks:     proc.PTicks,
 			Timestamp: currentTime,
+			IO:        proc.IO,
+			Net:       proc.Net,
 		})
 	}
 
@@ -226,9 +370,10 @@ func (self *GopsUtil) GetProcessesWithCursor(sortBy ProcSortBy, limit int, enabl
 func (self *GopsUtil) getProcessStaticInfo(p *process.Process) processStaticInfo {
 	pid := p.Pid
 
-	self.procStaticMu.RLock()
-	cached, exists := self.procStaticCache[pid]
-	self.procStaticMu.RUnlock()
+	cache := self.procStatic
+	cache.mu.RLock()
+	cached, exists := cache.entries[pid]
+	cache.mu.RUnlock()
 	if exists {
 		return cached
 	}
@@ -247,25 +392,26 @@ func (self *GopsUtil) getProcessStaticInfo(p *process.Process) processStaticInfo
 		ExePath:  exePath,
 	}
 
-	self.procStaticMu.Lock()
-	if self.procStaticCache == nil {
-		self.procStaticCache = make(map[int32]processStaticInfo)
+	cache.mu.Lock()
+	if cache.entries == nil {
+		cache.entries = make(map[int32]processStaticInfo)
 	}
-	if existing, ok := self.procStaticCache[pid]; ok {
-		self.procStaticMu.Unlock()
+	if existing, ok := cache.entries[pid]; ok {
+		cache.mu.Unlock()
 		return existing
 	}
-	self.procStaticCache[pid] = info
-	self.procStaticMu.Unlock()
+	cache.entries[pid] = info
+	cache.mu.Unlock()
 
 	return info
 }
 
 func (self *GopsUtil) pruneProcessStaticCache(procs []*process.Process) {
-	self.procStaticMu.Lock()
-	defer self.procStaticMu.Unlock()
+	cache := self.procStatic
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
 
-	if len(self.procStaticCache) == 0 {
+	if len(cache.entries) == 0 {
 		return
 	}
 
@@ -274,9 +420,9 @@ func (self *GopsUtil) pruneProcessStaticCache(procs []*process.Process) {
 		active[p.Pid] = struct{}{}
 	}
 
-	for pid := range self.procStaticCache {
+	for pid := range cache.entries {
 		if _, ok := active[pid]; !ok {
-			delete(self.procStaticCache, pid)
+			delete(cache.entries, pid)
 		}
 	}
 }
@@ -288,6 +434,8 @@ const (
 	SortByMemory ProcSortBy = "memory"
 	SortByName   ProcSortBy = "name"
 	SortByPID    ProcSortBy = "pid"
+	SortByIO     ProcSortBy = "io"
+	SortByNet    ProcSortBy = "net"
 )
 
 // Register enum in OpenAPI specification
@@ -301,6 +449,8 @@ func (u ProcSortBy) Schema(r huma.Registry) *huma.Schema {
 			string(SortByMemory),
 			string(SortByName),
 			string(SortByPID),
+			string(SortByIO),
+			string(SortByNet),
 		}...)
 		r.Map()["ProcSortBy"] = schemaRef
 	}
@@ -331,7 +481,7 @@ func calculateProcessCPUPercentageWithCursor(cursor *models.ProcessCursorData, c
 	return cpuPercent
 }
 
-func calculateNormalizedProcessCPUPercentageWithCursor(cursor *models.ProcessCursorData, currentCPUTime float64, currentTime int64, cpuCount int) float64 {
+func calculateNormalizedProcessCPUPercentageWithCursor(cursor *models.ProcessCursorData, currentCPUTime float64, currentTime int64, cpuCount float64) float64 {
 	if cpuCount <= 0 {
 		cpuCount = 1
 	}
@@ -346,7 +496,7 @@ func calculateNormalizedProcessCPUPercentageWithCursor(cursor *models.ProcessCur
 		return 0
 	}
 
-	cpuPercent := ((cpuTimeDiff / wallTimeDiff) * 100.0) / float64(cpuCount)
+	cpuPercent := ((cpuTimeDiff / wallTimeDiff) * 100.0) / cpuCount
 
 	if cpuPercent > 100.0 {
 		cpuPercent = 100.0
@@ -384,6 +534,10 @@ func mergeProcessesByExecutable(procList []*models.ProcessInfo) []*models.Proces
 		mergeRoots[p.PID] = root.PID
 	}
 
+	// namespace totals are shared by every process in the namespace, so each
+	// merged entry counts a namespace once
+	rootNetns := make(map[int32]map[string]bool)
+
 	rootProcs := make(map[int32]*models.ProcessInfo)
 	for _, p := range procList {
 		rootPID := mergeRoots[p.PID]
@@ -393,6 +547,10 @@ func mergeProcessesByExecutable(procList []*models.ProcessInfo) []*models.Proces
 			clone.ChildCount = 0
 			rootProcs[rootPID] = &clone
 			root = rootProcs[rootPID]
+			rootNetns[rootPID] = make(map[string]bool)
+			if root.Net != nil && root.Net.Source == procNetSourceNetns {
+				rootNetns[rootPID][root.Net.Netns] = true
+			}
 		}
 
 		if p.PID != rootPID {
@@ -403,6 +561,38 @@ func mergeProcessesByExecutable(procList []*models.ProcessInfo) []*models.Proces
 			root.RSSPercent += p.RSSPercent
 			root.PSSKB += p.PSSKB
 			root.PSSPercent += p.PSSPercent
+			root.IOReadRate += p.IOReadRate
+			root.IOWriteRate += p.IOWriteRate
+			root.IOReadCallRate += p.IOReadCallRate
+			root.IOWriteCallRate += p.IOWriteCallRate
+			if p.IO != nil {
+				sum := models.ProcessIOCounters{}
+				if root.IO != nil {
+					sum = *root.IO
+				}
+				sum.ReadBytes += p.IO.ReadBytes
+				sum.WriteBytes += p.IO.WriteBytes
+				sum.SyscR += p.IO.SyscR
+				sum.SyscW += p.IO.SyscW
+				root.IO = &sum
+			}
+			shared := p.Net != nil && p.Net.Source == procNetSourceNetns
+			if !shared || !rootNetns[rootPID][p.Net.Netns] {
+				if shared {
+					rootNetns[rootPID][p.Net.Netns] = true
+				}
+				root.NetRxRate += p.NetRxRate
+				root.NetTxRate += p.NetTxRate
+				if p.Net != nil {
+					sum := models.ProcessNetCounters{Source: p.Net.Source, Netns: p.Net.Netns}
+					if root.Net != nil {
+						sum = *root.Net
+					}
+					sum.RxBytes += p.Net.RxBytes
+					sum.TxBytes += p.Net.TxBytes
+					root.Net = &sum
+				}
+			}
 			root.ChildCount++
 		}
 	}
@@ -413,3 +603,89 @@ func mergeProcessesByExecutable(procList []*models.ProcessInfo) []*models.Proces
 	}
 	return result
 }
+
+// processesUnderRoots returns the unmerged processes whose merge root is one of roots
+func processesUnderRoots(all []*models.ProcessInfo, roots []*models.ProcessInfo) []*models.ProcessInfo {
+	pidMap := make(map[int32]*models.ProcessInfo, len(all))
+	for _, p := range all {
+		pidMap[p.PID] = p
+	}
+	keep := make(map[int32]bool, len(roots))
+	for _, root := range roots {
+		keep[root.PID] = true
+	}
+
+	var result []*models.ProcessInfo
+	for _, p := range all {
+		if keep[findMergeRoot(p, pidMap).PID] {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func (self *GopsUtil) primeProcessIO(procs []*process.Process, withNet bool) {
+	now := time.Now().UnixMilli()
+	var netCounters map[int32]*models.ProcessNetCounters
+	if withNet {
+		netCounters = self.readProcessNetCounters()
+	}
+	samples := make(map[int32]processIOSample, len(procs))
+	for _, p := range procs {
+		sample := processIOSample{net: netCounters[p.Pid], timestamp: now}
+		if counters, err := self.readProcessIO(p.Pid); err == nil {
+			sample.counters = counters
+		}
+		if sample.counters != nil || sample.net != nil {
+			samples[p.Pid] = sample
+		}
+	}
+	self.procIO.replace(samples)
+}
+
+func applyProcessIORates(info *models.ProcessInfo, prev *models.ProcessIOCounters, prevTime, currentTime int64) {
+	timeDiff := float64(currentTime-prevTime) / 1000.0
+	if timeDiff <= 0 || info.IO == nil || prev == nil {
+		return
+	}
+	info.IOReadRate = counterRate(info.IO.ReadBytes, prev.ReadBytes, timeDiff)
+	info.IOWriteRate = counterRate(info.IO.WriteBytes, prev.WriteBytes, timeDiff)
+	info.IOReadCallRate = counterRate(info.IO.SyscR, prev.SyscR, timeDiff)
+	info.IOWriteCallRate = counterRate(info.IO.SyscW, prev.SyscW, timeDiff)
+}
+
+// applyProcessNetRates skips samples from a different source, a process that
+// moved namespace or lost its last socket would otherwise show a bogus rate
+func applyProcessNetRates(info *models.ProcessInfo, prev *models.ProcessNetCounters, prevTime, currentTime int64) {
+	timeDiff := float64(currentTime-prevTime) / 1000.0
+	if timeDiff <= 0 || info.Net == nil || prev == nil || prev.Source != info.Net.Source {
+		return
+	}
+	info.NetRxRate = counterRate(info.Net.RxBytes, prev.RxBytes, timeDiff)
+	info.NetTxRate = counterRate(info.Net.TxBytes, prev.TxBytes, timeDiff)
+}
+
+// parseProcessIO reads /proc/<pid>/io. Write bytes exclude cancelled_write_bytes,
+// data that was dirtied and then truncated before it reached the disk.
+func parseProcessIO(content string) (*models.ProcessIOCounters, error) {
+	values := make(map[string]uint64)
+	for _, line := range strings.Split(content, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		if v, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64); err == nil {
+			values[key] = v
+		}
+	}
+	if _, ok := values["read_bytes"]; !ok {
+		return nil, fmt.Errorf("read_bytes not found in process io")
+	}
+
+	return &models.ProcessIOCounters{
+		ReadBytes:  values["read_bytes"],
+		WriteBytes: saturatingSub(values["write_bytes"], values["cancelled_write_bytes"]),
+		SyscR:      values["syscr"],
+		SyscW:      values["syscw"],
+	}, nil
+}
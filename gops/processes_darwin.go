@@ -2,8 +2,16 @@
 
 package gops
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
 
 func getPssDirty(_ int32) (uint64, error) {
 	return 0, fmt.Errorf("pss dirty is not supported on darwin")
 }
+
+func (self *GopsUtil) readProcessIO(_ int32) (*models.ProcessIOCounters, error) {
+	return nil, fmt.Errorf("per-process io is not supported on darwin")
+}
@@ -7,6 +7,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
 )
 
 func getPssDirty(pid int32) (uint64, error) {
@@ -31,3 +33,11 @@ func getPssDirty(pid int32) (uint64, error) {
 	}
 	return 0, fmt.Errorf("Pss_Dirty not found")
 }
+
+func (self *GopsUtil) readProcessIO(pid int32) (*models.ProcessIOCounters, error) {
+	contents, err := self.fs.ReadFile(fmt.Sprintf("/proc/%d/io", pid))
+	if err != nil {
+		return nil, err
+	}
+	return parseProcessIO(string(contents))
+}
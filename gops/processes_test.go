@@ -227,3 +227,143 @@ func BenchmarkCalculateProcessCPUPercentage(b *testing.B) {
 		calculateProcessCPUPercentageWithCursor(cursor, currentCPUTime, currentTime)
 	}
 }
+
+func TestParseProcessIO(t *testing.T) {
+	counters, err := parseProcessIO(`rchar: 323934931
+wchar: 323929600
+syscr: 632687
+syscw: 632675
+read_bytes: 8192
+write_bytes: 323932160
+cancelled_write_bytes: 1024
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ProcessIOCounters{
+		ReadBytes:  8192,
+		WriteBytes: 323931136,
+		SyscR:      632687,
+		SyscW:      632675,
+	}, counters)
+
+	_, err = parseProcessIO("rchar: 1\n")
+	assert.Error(t, err)
+}
+
+func TestApplyProcessIORates(t *testing.T) {
+	info := &models.ProcessInfo{
+		IO: &models.ProcessIOCounters{ReadBytes: 3000, WriteBytes: 10000, SyscR: 50, SyscW: 20},
+	}
+	prev := &models.ProcessIOCounters{ReadBytes: 1000, WriteBytes: 4000, SyscR: 10, SyscW: 20}
+
+	applyProcessIORates(info, prev, 1000, 3000)
+	assert.Equal(t, 1000.0, info.IOReadRate)
+	assert.Equal(t, 3000.0, info.IOWriteRate)
+	assert.Equal(t, 20.0, info.IOReadCallRate)
+	assert.Equal(t, 0.0, info.IOWriteCallRate)
+
+	// A reused PID can report lower counters than the cursor
+	reset := &models.ProcessInfo{IO: &models.ProcessIOCounters{ReadBytes: 10}}
+	applyProcessIORates(reset, prev, 1000, 3000)
+	assert.Equal(t, 0.0, reset.IOReadRate)
+
+	same := &models.ProcessInfo{IO: &models.ProcessIOCounters{ReadBytes: 5000}}
+	applyProcessIORates(same, prev, 3000, 3000)
+	assert.Equal(t, 0.0, same.IOReadRate)
+}
+
+func TestApplyProcessNetRates(t *testing.T) {
+	info := &models.ProcessInfo{
+		Net: &models.ProcessNetCounters{RxBytes: 50000, TxBytes: 4000, Source: "socket"},
+	}
+	prev := &models.ProcessNetCounters{RxBytes: 10000, TxBytes: 3000, Source: "socket"}
+
+	applyProcessNetRates(info, prev, 1000, 3000)
+	assert.Equal(t, 20000.0, info.NetRxRate)
+	assert.Equal(t, 500.0, info.NetTxRate)
+
+	// Closing a busy socket drops the per-process sum below the cursor
+	closed := &models.ProcessInfo{Net: &models.ProcessNetCounters{RxBytes: 100, TxBytes: 100, Source: "socket"}}
+	applyProcessNetRates(closed, prev, 1000, 3000)
+	assert.Equal(t, 0.0, closed.NetRxRate)
+	assert.Equal(t, 0.0, closed.NetTxRate)
+
+	moved := &models.ProcessInfo{Net: &models.ProcessNetCounters{RxBytes: 90000000, Source: "netns"}}
+	applyProcessNetRates(moved, prev, 1000, 3000)
+	assert.Equal(t, 0.0, moved.NetRxRate)
+
+	noPrev := &models.ProcessInfo{Net: &models.ProcessNetCounters{RxBytes: 5000}}
+	applyProcessNetRates(noPrev, nil, 1000, 3000)
+	assert.Equal(t, 0.0, noPrev.NetRxRate)
+}
+
+func TestMergeProcessesSumsNet(t *testing.T) {
+	procs := []*models.ProcessInfo{
+		{PID: 10, PPID: 1, ExecutablePath: "/usr/bin/firefox", Net: &models.ProcessNetCounters{RxBytes: 100, TxBytes: 10, Source: "socket"}, NetRxRate: 1000},
+		{PID: 11, PPID: 10, ExecutablePath: "/usr/bin/firefox", Net: &models.ProcessNetCounters{RxBytes: 900, TxBytes: 90, Source: "socket"}, NetRxRate: 4000, NetTxRate: 300},
+		{PID: 12, PPID: 10, ExecutablePath: "/usr/bin/firefox"},
+	}
+
+	merged := mergeProcessesByExecutable(procs)
+	assert.Len(t, merged, 1)
+	assert.Equal(t, 5000.0, merged[0].NetRxRate)
+	assert.Equal(t, 300.0, merged[0].NetTxRate)
+	assert.Equal(t, &models.ProcessNetCounters{RxBytes: 1000, TxBytes: 100, Source: "socket"}, merged[0].Net)
+	assert.Equal(t, uint64(100), procs[0].Net.RxBytes)
+}
+
+func TestMergeProcessesCountsNetnsOnce(t *testing.T) {
+	shared := func() *models.ProcessNetCounters {
+		return &models.ProcessNetCounters{RxBytes: 5000, TxBytes: 700, Source: "netns", Netns: "net:[4026532999]"}
+	}
+	procs := []*models.ProcessInfo{
+		{PID: 200, PPID: 1, ExecutablePath: "/usr/sbin/nginx", Net: shared(), NetRxRate: 100, NetTxRate: 10},
+		{PID: 201, PPID: 200, ExecutablePath: "/usr/sbin/nginx", Net: shared(), NetRxRate: 100, NetTxRate: 10},
+		{PID: 202, PPID: 200, ExecutablePath: "/usr/sbin/nginx", Net: shared(), NetRxRate: 100, NetTxRate: 10},
+		{PID: 203, PPID: 200, ExecutablePath: "/usr/sbin/nginx", Net: &models.ProcessNetCounters{RxBytes: 40, TxBytes: 4, Source: "netns", Netns: "net:[4026533000]"}, NetRxRate: 3},
+	}
+
+	merged := mergeProcessesByExecutable(procs)
+	assert.Len(t, merged, 1)
+	assert.Equal(t, 3, merged[0].ChildCount)
+	assert.Equal(t, 103.0, merged[0].NetRxRate)
+	assert.Equal(t, 10.0, merged[0].NetTxRate)
+	assert.Equal(t, uint64(5040), merged[0].Net.RxBytes)
+	assert.Equal(t, uint64(704), merged[0].Net.TxBytes)
+}
+
+func TestMergeProcessesSumsIO(t *testing.T) {
+	rootIO := &models.ProcessIOCounters{ReadBytes: 100, WriteBytes: 200, SyscR: 1, SyscW: 2}
+	procs := []*models.ProcessInfo{
+		{PID: 10, PPID: 1, ExecutablePath: "/usr/bin/postgres", IO: rootIO, IOReadRate: 10, IOWriteRate: 20, IOReadCallRate: 1},
+		{PID: 11, PPID: 10, ExecutablePath: "/usr/bin/postgres", IO: &models.ProcessIOCounters{ReadBytes: 1000, WriteBytes: 2000, SyscR: 3, SyscW: 4}, IOReadRate: 100, IOWriteRate: 200, IOWriteCallRate: 5},
+		{PID: 12, PPID: 10, ExecutablePath: "/usr/bin/postgres"},
+		{PID: 20, PPID: 1, ExecutablePath: "/usr/bin/rsync", IOReadRate: 7},
+	}
+
+	merged := mergeProcessesByExecutable(procs)
+	assert.Len(t, merged, 2)
+
+	var postgres *models.ProcessInfo
+	for _, p := range merged {
+		if p.PID == 10 {
+			postgres = p
+		}
+	}
+	assert.NotNil(t, postgres)
+	assert.Equal(t, 2, postgres.ChildCount)
+	assert.Equal(t, 110.0, postgres.IOReadRate)
+	assert.Equal(t, 220.0, postgres.IOWriteRate)
+	assert.Equal(t, 1.0, postgres.IOReadCallRate)
+	assert.Equal(t, 5.0, postgres.IOWriteCallRate)
+	assert.Equal(t, &models.ProcessIOCounters{ReadBytes: 1100, WriteBytes: 2200, SyscR: 4, SyscW: 6}, postgres.IO)
+
+	// The unmerged root keeps its own counters for the cursor
+	assert.Equal(t, uint64(100), rootIO.ReadBytes)
+
+	under := processesUnderRoots(procs, []*models.ProcessInfo{postgres})
+	pids := make([]int32, 0, len(under))
+	for _, p := range under {
+		pids = append(pids, p.PID)
+	}
+	assert.Equal(t, []int32{10, 11, 12}, pids)
+}
@@ -0,0 +1,10 @@
+//go:build darwin
+
+package gops
+
+import "github.com/AvengeMedia/dgop/models"
+
+// macOS has no sock_diag or per-process network namespaces
+func (self *GopsUtil) readProcessNetCounters() map[int32]*models.ProcessNetCounters {
+	return nil
+}
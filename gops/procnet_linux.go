@@ -0,0 +1,237 @@
+//go:build linux
+
+package gops
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// sock_diag(7) request and attribute numbers, see include/uapi/linux/inet_diag.h
+const (
+	sockDiagByFamily = 20
+	inetDiagInfo     = 2
+
+	inetDiagReqV2Len = 56
+	inetDiagMsgLen   = 72
+
+	// offsets of bytes_acked and bytes_received in struct tcp_info (Linux 4.1+)
+	tcpInfoBytesAcked    = 120
+	tcpInfoBytesReceived = 128
+)
+
+type socketBytes struct {
+	rx uint64
+	tx uint64
+}
+
+// readProcessNetCounters attributes network bytes to processes. TCP sockets in
+// our namespace are summed per owning process from sock_diag counters; processes
+// in another network namespace (containers) get their namespace's interface totals,
+// shared by every process in it and tagged with the namespace so callers can
+// count them once.
+func (self *GopsUtil) readProcessNetCounters() map[int32]*models.ProcessNetCounters {
+	return self.readProcessNetCountersFrom("/proc", dumpTCPSocketBytes)
+}
+
+func (self *GopsUtil) readProcessNetCountersFrom(procRoot string, dump func() (map[uint64]socketBytes, error)) map[int32]*models.ProcessNetCounters {
+	counters := make(map[int32]*models.ProcessNetCounters)
+
+	selfNS, _ := self.fs.Readlink(filepath.Join(procRoot, "self", "ns", "net"))
+	nsTotals := make(map[string]*models.ProcessNetCounters)
+	hostPIDs := make(map[int32]bool)
+
+	for _, entry := range self.listSysfsDir(procRoot) {
+		pid, err := strconv.ParseInt(entry, 10, 32)
+		if err != nil {
+			continue
+		}
+
+		ns, err := self.fs.Readlink(filepath.Join(procRoot, entry, "ns", "net"))
+		if err != nil || ns == selfNS {
+			hostPIDs[int32(pid)] = true
+			continue
+		}
+
+		totals, seen := nsTotals[ns]
+		if !seen {
+			totals = self.readNetnsTotals(filepath.Join(procRoot, entry, "net", "dev"), ns)
+			nsTotals[ns] = totals
+		}
+		if totals != nil {
+			c := *totals
+			counters[int32(pid)] = &c
+		}
+	}
+
+	sockets, err := dump()
+	if err != nil {
+		return counters
+	}
+
+	for inode, owner := range self.readSocketOwners(procRoot) {
+		b, ok := sockets[inode]
+		if !ok || !hostPIDs[owner.pid] {
+			continue
+		}
+		c := counters[owner.pid]
+		if c == nil {
+			c = &models.ProcessNetCounters{Source: procNetSourceSocket}
+			counters[owner.pid] = c
+		}
+		c.RxBytes += b.rx
+		c.TxBytes += b.tx
+	}
+
+	return counters
+}
+
+func (self *GopsUtil) readNetnsTotals(path, ns string) *models.ProcessNetCounters {
+	data, err := self.fs.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	rx, tx := parseNetDevTotals(string(data))
+	return &models.ProcessNetCounters{RxBytes: rx, TxBytes: tx, Source: procNetSourceNetns, Netns: ns}
+}
+
+// parseNetDevTotals sums receive and transmit bytes over every interface
+// in /proc/<pid>/net/dev except loopback
+func parseNetDevTotals(content string) (uint64, uint64) {
+	var rx, tx uint64
+	for _, line := range strings.Split(content, "\n") {
+		name, rest, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(name) == "lo" {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) < 9 {
+			continue
+		}
+		r, err1 := strconv.ParseUint(fields[0], 10, 64)
+		t, err2 := strconv.ParseUint(fields[8], 10, 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		rx += r
+		tx += t
+	}
+	return rx, tx
+}
+
+// dumpTCPSocketBytes asks the kernel for tcp_info on every IPv4 and IPv6 TCP
+// socket in this network namespace, keyed by socket inode
+func dumpTCPSocketBytes() (map[uint64]socketBytes, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, syscall.NETLINK_INET_DIAG)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sock_diag socket: %w", err)
+	}
+	defer syscall.Close(fd)
+
+	sockets := make(map[uint64]socketBytes)
+	for _, family := range []uint8{syscall.AF_INET, syscall.AF_INET6} {
+		if err := sockDiagDump(fd, family, sockets); err != nil {
+			return nil, err
+		}
+	}
+	return sockets, nil
+}
+
+func sockDiagDump(fd int, family uint8, sockets map[uint64]socketBytes) error {
+	req := buildInetDiagRequest(family, uint32(os.Getpid()))
+	if err := syscall.Sendto(fd, req, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		return fmt.Errorf("failed to send sock_diag request: %w", err)
+	}
+
+	buf := make([]byte, 64*1024)
+	for {
+		n, _, err := syscall.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return fmt.Errorf("failed to read sock_diag response: %w", err)
+		}
+		done, err := parseInetDiagMessages(buf[:n], sockets)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+func buildInetDiagRequest(family uint8, seq uint32) []byte {
+	req := make([]byte, syscall.NLMSG_HDRLEN+inetDiagReqV2Len)
+	binary.NativeEndian.PutUint32(req[0:], uint32(len(req)))
+	binary.NativeEndian.PutUint16(req[4:], sockDiagByFamily)
+	binary.NativeEndian.PutUint16(req[6:], syscall.NLM_F_REQUEST|syscall.NLM_F_DUMP)
+	binary.NativeEndian.PutUint32(req[8:], seq)
+
+	body := req[syscall.NLMSG_HDRLEN:]
+	body[0] = family
+	body[1] = syscall.IPPROTO_TCP
+	body[2] = 1 << (inetDiagInfo - 1)
+	binary.NativeEndian.PutUint32(body[4:], 0xffffffff) // every TCP state
+	return req
+}
+
+// parseInetDiagMessages reads one recvfrom worth of inet_diag_msg replies and
+// reports whether the dump is complete
+func parseInetDiagMessages(data []byte, sockets map[uint64]socketBytes) (bool, error) {
+	msgs, err := syscall.ParseNetlinkMessage(data)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse sock_diag response: %w", err)
+	}
+
+	for _, msg := range msgs {
+		switch msg.Header.Type {
+		case syscall.NLMSG_DONE:
+			return true, nil
+		case syscall.NLMSG_ERROR:
+			if len(msg.Data) >= 4 {
+				if errno := int32(binary.NativeEndian.Uint32(msg.Data)); errno != 0 {
+					return false, fmt.Errorf("sock_diag request failed: %w", syscall.Errno(-errno))
+				}
+			}
+			return true, nil
+		}
+
+		if msg.Header.Type != sockDiagByFamily || len(msg.Data) < inetDiagMsgLen {
+			continue
+		}
+		inode := uint64(binary.NativeEndian.Uint32(msg.Data[68:]))
+
+		info := netlinkAttr(msg.Data[inetDiagMsgLen:], inetDiagInfo)
+		if len(info) < tcpInfoBytesReceived+8 {
+			continue
+		}
+		sockets[inode] = socketBytes{
+			tx: binary.NativeEndian.Uint64(info[tcpInfoBytesAcked:]),
+			rx: binary.NativeEndian.Uint64(info[tcpInfoBytesReceived:]),
+		}
+	}
+
+	return false, nil
+}
+
+// netlinkAttr returns the payload of the first rtattr of the given type
+func netlinkAttr(data []byte, attrType uint16) []byte {
+	for len(data) >= syscall.SizeofRtAttr {
+		length := int(binary.NativeEndian.Uint16(data[0:]))
+		if length < syscall.SizeofRtAttr || length > len(data) {
+			return nil
+		}
+		if binary.NativeEndian.Uint16(data[2:]) == attrType {
+			return data[syscall.SizeofRtAttr:length]
+		}
+		aligned := (length + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+		if aligned > len(data) {
+			return nil
+		}
+		data = data[aligned:]
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+//go:build linux
+
+package gops
+
+import (
+	"encoding/binary"
+	"errors"
+	"syscall"
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inetDiagReply builds one SOCK_DIAG_BY_FAMILY message carrying an INET_DIAG_INFO attribute
+func inetDiagReply(inode uint32, acked, received uint64) []byte {
+	info := make([]byte, tcpInfoBytesReceived+8)
+	binary.NativeEndian.PutUint64(info[tcpInfoBytesAcked:], acked)
+	binary.NativeEndian.PutUint64(info[tcpInfoBytesReceived:], received)
+
+	// an unrelated attribute first, to exercise the attribute walk
+	other := make([]byte, syscall.SizeofRtAttr+5)
+	binary.NativeEndian.PutUint16(other[0:], uint16(len(other)))
+	binary.NativeEndian.PutUint16(other[2:], 1)
+
+	attr := make([]byte, syscall.SizeofRtAttr+len(info))
+	binary.NativeEndian.PutUint16(attr[0:], uint16(len(attr)))
+	binary.NativeEndian.PutUint16(attr[2:], inetDiagInfo)
+	copy(attr[syscall.SizeofRtAttr:], info)
+
+	body := make([]byte, inetDiagMsgLen)
+	body[0] = syscall.AF_INET
+	body[1] = 0x01
+	binary.NativeEndian.PutUint32(body[68:], inode)
+	body = append(body, other...)
+	body = append(body, make([]byte, 3)...) // pad the 9-byte attribute to 12
+	body = append(body, attr...)
+
+	return netlinkMessage(sockDiagByFamily, body)
+}
+
+func netlinkMessage(msgType uint16, body []byte) []byte {
+	msg := make([]byte, syscall.NLMSG_HDRLEN, syscall.NLMSG_HDRLEN+len(body))
+	binary.NativeEndian.PutUint32(msg[0:], uint32(syscall.NLMSG_HDRLEN+len(body)))
+	binary.NativeEndian.PutUint16(msg[4:], msgType)
+	return append(msg, body...)
+}
+
+func TestBuildInetDiagRequest(t *testing.T) {
+	req := buildInetDiagRequest(syscall.AF_INET6, 42)
+
+	require.Len(t, req, syscall.NLMSG_HDRLEN+inetDiagReqV2Len)
+	assert.Equal(t, uint32(len(req)), binary.NativeEndian.Uint32(req[0:]))
+	assert.Equal(t, uint16(sockDiagByFamily), binary.NativeEndian.Uint16(req[4:]))
+	assert.Equal(t, uint16(syscall.NLM_F_REQUEST|syscall.NLM_F_DUMP), binary.NativeEndian.Uint16(req[6:]))
+	assert.Equal(t, uint32(42), binary.NativeEndian.Uint32(req[8:]))
+
+	body := req[syscall.NLMSG_HDRLEN:]
+	assert.Equal(t, uint8(syscall.AF_INET6), body[0])
+	assert.Equal(t, uint8(syscall.IPPROTO_TCP), body[1])
+	assert.Equal(t, uint8(0x2), body[2])
+	assert.Equal(t, uint32(0xffffffff), binary.NativeEndian.Uint32(body[4:]))
+}
+
+func TestParseInetDiagMessages(t *testing.T) {
+	var data []byte
+	data = append(data, inetDiagReply(41234, 5000, 900000)...)
+	data = append(data, inetDiagReply(41235, 12, 34)...)
+
+	sockets := make(map[uint64]socketBytes)
+	done, err := parseInetDiagMessages(data, sockets)
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, map[uint64]socketBytes{
+		41234: {rx: 900000, tx: 5000},
+		41235: {rx: 34, tx: 12},
+	}, sockets)
+
+	done, err = parseInetDiagMessages(netlinkMessage(syscall.NLMSG_DONE, make([]byte, 4)), sockets)
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	errBody := make([]byte, 4)
+	binary.NativeEndian.PutUint32(errBody, uint32(0x100000000-int64(syscall.EPERM)))
+	_, err = parseInetDiagMessages(netlinkMessage(syscall.NLMSG_ERROR, errBody), sockets)
+	assert.ErrorIs(t, err, syscall.EPERM)
+}
+
+func TestParseNetDevTotals(t *testing.T) {
+	content := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:  123456     100    0    0    0     0          0         0   123456     100    0    0    0     0       0          0
+  eth0: 9000000    7000    0    0    0     0          0         0   250000    3000    0    0    0     0       0          0
+ wg0:    1000      10    0    0    0     0          0         0     2000      20    0    0    0     0       0          0
+`
+	rx, tx := parseNetDevTotals(content)
+	assert.Equal(t, uint64(9001000), rx)
+	assert.Equal(t, uint64(252000), tx)
+}
+
+func TestReadProcessNetCountersFrom(t *testing.T) {
+	gops, fsys := newFixtureGops(map[string]string{
+		"/proc/100/comm":    "curl",
+		"/proc/200/comm":    "nginx",
+		"/proc/200/net/dev": "Inter-|\n face |\n  eth0: 5000 1 0 0 0 0 0 0 700 1 0 0 0 0 0 0",
+		"/proc/201/comm":    "nginx",
+		"/proc/201/net/dev": "Inter-|\n face |\n  eth0: 5000 1 0 0 0 0 0 0 700 1 0 0 0 0 0 0",
+	})
+	fsys.symlink("/proc/self/ns/net", "net:[4026531840]")
+	fsys.symlink("/proc/100/ns/net", "net:[4026531840]")
+	fsys.symlink("/proc/200/ns/net", "net:[4026532999]")
+	fsys.symlink("/proc/201/ns/net", "net:[4026532999]")
+	fsys.symlink("/proc/100/fd/3", "socket:[41234]")
+	fsys.symlink("/proc/100/fd/4", "socket:[41235]")
+	fsys.symlink("/proc/100/fd/5", "socket:[50000]")
+
+	dump := func() (map[uint64]socketBytes, error) {
+		return map[uint64]socketBytes{
+			41234: {rx: 1000, tx: 10},
+			41235: {rx: 2000, tx: 20},
+			77777: {rx: 9, tx: 9},
+		}, nil
+	}
+
+	counters := gops.readProcessNetCountersFrom("/proc", dump)
+	assert.Equal(t, map[int32]*models.ProcessNetCounters{
+		100: {RxBytes: 3000, TxBytes: 30, Source: "socket"},
+		200: {RxBytes: 5000, TxBytes: 700, Source: "netns", Netns: "net:[4026532999]"},
+		201: {RxBytes: 5000, TxBytes: 700, Source: "netns", Netns: "net:[4026532999]"},
+	}, counters)
+
+	// Without sock_diag only the namespaced processes can be attributed
+	failing := func() (map[uint64]socketBytes, error) { return nil, errors.New("EPERM") }
+	counters = gops.readProcessNetCountersFrom("/proc", failing)
+	assert.Len(t, counters, 2)
+	assert.Nil(t, counters[100])
+}
@@ -0,0 +1,194 @@
+package gops
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const hwmonPath = "/sys/class/hwmon"
+
+type hwmonChannelType struct {
+	unit  string
+	scale float64
+	order int
+}
+
+// Raw sysfs units: millidegrees, RPM, millivolts, milliamps, microwatts
+var hwmonChannelTypes = map[string]hwmonChannelType{
+	"temp":  {unit: "°C", scale: 1000, order: 0},
+	"fan":   {unit: "RPM", scale: 1, order: 1},
+	"in":    {unit: "V", scale: 1000, order: 2},
+	"curr":  {unit: "A", scale: 1000, order: 3},
+	"power": {unit: "W", scale: 1000000, order: 4},
+}
+
+var hwmonChannelRegex = regexp.MustCompile(`^(temp|fan|in|curr|power)(\d+)_(input|average)$`)
+
+var hwmonAlarmSuffixes = []string{"alarm", "min_alarm", "max_alarm", "crit_alarm", "lcrit_alarm", "fault"}
+
+func (self *GopsUtil) GetSensors() ([]*models.SensorChip, error) {
+	return self.readHwmonChips(hwmonPath)
+}
+
+func (self *GopsUtil) readHwmonChips(root string) ([]*models.SensorChip, error) {
+	entries, err := self.fs.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hwmon devices: %w", err)
+	}
+
+	var chips []*models.SensorChip
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "hwmon") {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+
+		chip := &models.SensorChip{
+			Name:  self.readHwmonString(filepath.Join(dir, "name")),
+			Hwmon: entry.Name(),
+		}
+		chip.Sensors = self.readHwmonChannels(dir)
+
+		// Older drivers keep their attributes on the parent device
+		if len(chip.Sensors) == 0 {
+			chip.Sensors = self.readHwmonChannels(filepath.Join(dir, "device"))
+			if chip.Name == "" {
+				chip.Name = self.readHwmonString(filepath.Join(dir, "device", "name"))
+			}
+		}
+		if len(chip.Sensors) == 0 {
+			continue
+		}
+
+		chips = append(chips, chip)
+	}
+
+	if len(chips) == 0 {
+		return nil, fmt.Errorf("no hwmon sensors found in %s", root)
+	}
+
+	sort.Slice(chips, func(i, j int) bool {
+		return hwmonIndex(chips[i].Hwmon) < hwmonIndex(chips[j].Hwmon)
+	})
+	return chips, nil
+}
+
+func (self *GopsUtil) readHwmonChannels(dir string) []*models.SensorReading {
+	entries, err := self.fs.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	// power channels may expose both _input and _average; the average is
+	// preferred whichever order the directory lists them in
+	type hwmonSource struct {
+		kind string
+		file string
+	}
+	sources := make(map[string]hwmonSource)
+	var channels []string
+	for _, entry := range entries {
+		match := hwmonChannelRegex.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		channel := match[1] + match[2]
+		if _, ok := sources[channel]; !ok {
+			channels = append(channels, channel)
+		} else if match[3] != "average" {
+			continue
+		}
+		sources[channel] = hwmonSource{kind: match[1], file: entry.Name()}
+	}
+
+	var readings []*models.SensorReading
+	for _, channel := range channels {
+		source := sources[channel]
+		channelType := hwmonChannelTypes[source.kind]
+		input, err := self.readHwmonValue(filepath.Join(dir, source.file), channelType.scale)
+		if err != nil {
+			continue
+		}
+
+		reading := &models.SensorReading{
+			Type:    source.kind,
+			Channel: channel,
+			Label:   self.readHwmonString(filepath.Join(dir, channel+"_label")),
+			Unit:    channelType.unit,
+			Input:   input,
+		}
+		reading.Min = self.readHwmonLimit(filepath.Join(dir, channel+"_min"), channelType.scale)
+		reading.Max = self.readHwmonLimit(filepath.Join(dir, channel+"_max"), channelType.scale)
+		if reading.Max == nil && source.kind == "power" {
+			reading.Max = self.readHwmonLimit(filepath.Join(dir, channel+"_cap"), channelType.scale)
+		}
+		reading.Crit = self.readHwmonLimit(filepath.Join(dir, channel+"_crit"), channelType.scale)
+
+		for _, suffix := range hwmonAlarmSuffixes {
+			if flag, err := self.readHwmonValue(filepath.Join(dir, channel+"_"+suffix), 1); err == nil && flag != 0 {
+				reading.Alarms = append(reading.Alarms, strings.TrimSuffix(suffix, "_alarm"))
+			}
+		}
+
+		readings = append(readings, reading)
+	}
+
+	sort.Slice(readings, func(i, j int) bool {
+		a, b := readings[i], readings[j]
+		if a.Type != b.Type {
+			return hwmonChannelTypes[a.Type].order < hwmonChannelTypes[b.Type].order
+		}
+		return hwmonIndex(a.Channel) < hwmonIndex(b.Channel)
+	})
+	return readings
+}
+
+func (self *GopsUtil) readHwmonString(path string) string {
+	data, err := self.fs.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func (self *GopsUtil) readHwmonValue(path string, scale float64) (float64, error) {
+	data, err := self.fs.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+	if err != nil {
+		return 0, err
+	}
+	return value / scale, nil
+}
+
+// readHwmonLimit returns nil when the limit attribute is absent, so a real
+// limit of zero can be told apart from none.
+func (self *GopsUtil) readHwmonLimit(path string, scale float64) *float64 {
+	value, err := self.readHwmonValue(path, scale)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// hwmonIndex extracts the trailing number from names like "hwmon3" or "temp12"
+// so that hwmon10 sorts after hwmon9.
+func hwmonIndex(name string) int {
+	i := len(name)
+	for i > 0 && name[i-1] >= '0' && name[i-1] <= '9' {
+		i--
+	}
+	idx, err := strconv.Atoi(name[i:])
+	if err != nil {
+		return -1
+	}
+	return idx
+}
@@ -0,0 +1,124 @@
+//go:build linux
+
+package gops
+
+import (
+	"io/fs"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadHwmonChips(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/class/hwmon/hwmon0/name":               "acpitz",
+		"/sys/class/hwmon/hwmon0/temp1_input":        "27800",
+		"/sys/class/hwmon/hwmon0/temp1_crit":         "105000",
+		"/sys/class/hwmon/hwmon10/name":              "nct6798",
+		"/sys/class/hwmon/hwmon10/temp2_input":       "45500",
+		"/sys/class/hwmon/hwmon10/temp2_label":       "CPUTIN",
+		"/sys/class/hwmon/hwmon10/temp2_max":         "80000",
+		"/sys/class/hwmon/hwmon10/temp2_alarm":       "1",
+		"/sys/class/hwmon/hwmon10/temp10_input":      "30000",
+		"/sys/class/hwmon/hwmon10/fan1_input":        "1180",
+		"/sys/class/hwmon/hwmon10/fan1_min":          "300",
+		"/sys/class/hwmon/hwmon10/fan1_min_alarm":    "0",
+		"/sys/class/hwmon/hwmon10/in0_input":         "1032",
+		"/sys/class/hwmon/hwmon10/in0_label":         "Vcore",
+		"/sys/class/hwmon/hwmon10/in0_min":           "0",
+		"/sys/class/hwmon/hwmon10/curr1_input":       "2500",
+		"/sys/class/hwmon/hwmon10/power1_average":    "45250000",
+		"/sys/class/hwmon/hwmon10/power1_input":      "45000000",
+		"/sys/class/hwmon/hwmon10/power1_cap":        "65000000",
+		"/sys/class/hwmon/hwmon2/device/name":        "legacy",
+		"/sys/class/hwmon/hwmon2/device/temp1_input": "50000",
+		"/sys/class/hwmon/hwmon3/name":               "empty",
+		"/sys/class/hwmon/hwmon3/uevent":             "",
+	})
+
+	chips, err := gops.readHwmonChips(hwmonPath)
+	require.NoError(t, err)
+	require.Len(t, chips, 3)
+
+	assert.Equal(t, "acpitz", chips[0].Name)
+	require.Len(t, chips[0].Sensors, 1)
+	assert.Equal(t, 27.8, chips[0].Sensors[0].Input)
+	require.NotNil(t, chips[0].Sensors[0].Crit)
+	assert.Equal(t, 105.0, *chips[0].Sensors[0].Crit)
+	assert.Nil(t, chips[0].Sensors[0].Min)
+	assert.Nil(t, chips[0].Sensors[0].Max)
+	assert.Equal(t, "°C", chips[0].Sensors[0].Unit)
+
+	assert.Equal(t, "legacy", chips[1].Name)
+	assert.Equal(t, "hwmon2", chips[1].Hwmon)
+
+	nct := chips[2]
+	assert.Equal(t, "hwmon10", nct.Hwmon)
+	require.Len(t, nct.Sensors, 6)
+
+	temp2 := nct.Sensors[0]
+	assert.Equal(t, "temp2", temp2.Channel)
+	assert.Equal(t, "CPUTIN", temp2.Label)
+	require.NotNil(t, temp2.Max)
+	assert.Equal(t, 80.0, *temp2.Max)
+	assert.Equal(t, []string{"alarm"}, temp2.Alarms)
+	assert.Equal(t, "temp10", nct.Sensors[1].Channel)
+
+	fan := nct.Sensors[2]
+	assert.Equal(t, "fan", fan.Type)
+	assert.Equal(t, 1180.0, fan.Input)
+	require.NotNil(t, fan.Min)
+	assert.Equal(t, 300.0, *fan.Min)
+	assert.Empty(t, fan.Alarms)
+
+	assert.Equal(t, "Vcore", nct.Sensors[3].Label)
+	assert.InDelta(t, 1.032, nct.Sensors[3].Input, 0.0001)
+	require.NotNil(t, nct.Sensors[3].Min, "a zero limit is kept")
+	assert.Equal(t, 0.0, *nct.Sensors[3].Min)
+	assert.Equal(t, 2.5, nct.Sensors[4].Input)
+
+	power := nct.Sensors[5]
+	assert.Equal(t, "power1", power.Channel)
+	assert.Equal(t, 45.25, power.Input)
+	require.NotNil(t, power.Max)
+	assert.Equal(t, 65.0, *power.Max)
+}
+
+// reversedDirFS lists directories back to front to catch order dependence
+type reversedDirFS struct {
+	FileSystem
+}
+
+func (r reversedDirFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := r.FileSystem.ReadDir(name)
+	slices.Reverse(entries)
+	return entries, err
+}
+
+func TestReadHwmonChannelsPrefersAverage(t *testing.T) {
+	files := newFixtureFS(map[string]string{
+		"/sys/class/hwmon/hwmon0/power1_average": "45250000",
+		"/sys/class/hwmon/hwmon0/power1_input":   "45000000",
+	})
+
+	for _, fsys := range []FileSystem{files, reversedDirFS{files}} {
+		gops := NewGopsUtilWithProviders(nil, nil, nil, nil, nil, nil, nil, fsys, nil)
+		readings := gops.readHwmonChannels("/sys/class/hwmon/hwmon0")
+		require.Len(t, readings, 1)
+		assert.Equal(t, 45.25, readings[0].Input)
+	}
+}
+
+func TestReadHwmonChipsMissingRoot(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	_, err := gops.readHwmonChips(hwmonPath)
+	assert.Error(t, err)
+}
+
+func TestHwmonIndex(t *testing.T) {
+	assert.Equal(t, 10, hwmonIndex("hwmon10"))
+	assert.Equal(t, 2, hwmonIndex("temp2"))
+	assert.Equal(t, -1, hwmonIndex("name"))
+}
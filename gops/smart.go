@@ -0,0 +1,278 @@
+package gops
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const smartctlCommand = "smartctl"
+
+// smartctl(8) exit status bits that mean no SMART data was read
+const (
+	smartExitCommandLine = 1 << 0
+	smartExitOpenFailed  = 1 << 1
+)
+
+// NVMe data units are reported in thousands of 512-byte blocks
+const nvmeDataUnitSize = 1000 * 512
+
+const (
+	smartAttrReallocated   = 5
+	smartAttrPending       = 197
+	smartAttrUncorrectable = 198
+)
+
+var (
+	nvmeControllerRegex = regexp.MustCompile(`^(nvme\d+)`)
+	// printed by -n standby, e.g. "Device is in STANDBY mode, exit(2)"
+	smartPowerModeRegex = regexp.MustCompile(`^Device is in (\w+) mode`)
+)
+
+type smartctlScan struct {
+	Devices []struct {
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Protocol string `json:"protocol"`
+	} `json:"devices"`
+}
+
+type smartctlOutput struct {
+	Smartctl struct {
+		ExitStatus int `json:"exit_status"`
+		Messages   []struct {
+			String   string `json:"string"`
+			Severity string `json:"severity"`
+		} `json:"messages"`
+	} `json:"smartctl"`
+	Device struct {
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Protocol string `json:"protocol"`
+	} `json:"device"`
+	ModelName       string `json:"model_name"`
+	SerialNumber    string `json:"serial_number"`
+	FirmwareVersion string `json:"firmware_version"`
+	UserCapacity    struct {
+		Bytes uint64 `json:"bytes"`
+	} `json:"user_capacity"`
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature struct {
+		Current float64 `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime struct {
+		Hours uint64 `json:"hours"`
+	} `json:"power_on_time"`
+	PowerCycleCount    uint64 `json:"power_cycle_count"`
+	ATASmartAttributes struct {
+		Table []struct {
+			ID  int `json:"id"`
+			Raw struct {
+				Value uint64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NVMeHealth *struct {
+		CriticalWarning  int    `json:"critical_warning"`
+		AvailableSpare   int    `json:"available_spare"`
+		PercentageUsed   int    `json:"percentage_used"`
+		DataUnitsRead    uint64 `json:"data_units_read"`
+		DataUnitsWritten uint64 `json:"data_units_written"`
+		UnsafeShutdowns  uint64 `json:"unsafe_shutdowns"`
+		MediaErrors      uint64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+}
+
+func (self *GopsUtil) GetSmart() ([]*models.SmartDevice, error) {
+	out, err := self.cmd.Execute(smartctlCommand, "--json", "--scan")
+	if err != nil && len(out) == 0 {
+		fallback, fallbackErr := self.readNVMeHealth()
+		if fallbackErr != nil {
+			return nil, fmt.Errorf("smartctl unavailable (%v) and %w", err, fallbackErr)
+		}
+		return fallback, nil
+	}
+
+	var scan smartctlScan
+	if err := json.Unmarshal(out, &scan); err != nil {
+		return nil, fmt.Errorf("failed to parse smartctl scan: %w", err)
+	}
+
+	// Without root smartctl cannot open NVMe controllers, sysfs still has identity and temperature
+	var nvmeFallback map[string]*models.SmartDevice
+
+	devices := make([]*models.SmartDevice, 0, len(scan.Devices))
+	for _, entry := range scan.Devices {
+		dev := self.readSmartDevice(entry.Name, entry.Type)
+		if dev.Error != "" && entry.Type == "nvme" {
+			if nvmeFallback == nil {
+				nvmeFallback = self.nvmeHealthByController()
+			}
+			if fallback, ok := nvmeFallback[nvmeController(dev.Name)]; ok {
+				fallback.Error = dev.Error
+				dev = fallback
+			}
+		}
+		devices = append(devices, dev)
+	}
+
+	return devices, nil
+}
+
+// readSmartDevice passes -n standby so polling never spins up a sleeping
+// drive; such a drive comes back with Skipped set instead of an error.
+func (self *GopsUtil) readSmartDevice(path, deviceType string) *models.SmartDevice {
+	args := []string{"--json", "-a", "-n", "standby"}
+	if deviceType != "" {
+		args = append(args, "-d", deviceType)
+	}
+	args = append(args, path)
+
+	out, err := self.cmd.Execute(smartctlCommand, args...)
+	if err != nil && len(out) == 0 {
+		return &models.SmartDevice{
+			Name:   filepath.Base(path),
+			Path:   path,
+			Type:   deviceType,
+			Source: smartctlCommand,
+			Health: "unknown",
+			Error:  err.Error(),
+		}
+	}
+
+	dev, parseErr := parseSmartctlOutput(out)
+	if parseErr != nil {
+		return &models.SmartDevice{
+			Name:   filepath.Base(path),
+			Path:   path,
+			Type:   deviceType,
+			Source: smartctlCommand,
+			Health: "unknown",
+			Error:  parseErr.Error(),
+		}
+	}
+	if dev.Path == "" {
+		dev.Path = path
+		dev.Name = filepath.Base(path)
+	}
+	return dev
+}
+
+func parseSmartctlOutput(data []byte) (*models.SmartDevice, error) {
+	var out smartctlOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse smartctl output: %w", err)
+	}
+
+	dev := &models.SmartDevice{
+		Path:         out.Device.Name,
+		Type:         out.Device.Type,
+		Protocol:     out.Device.Protocol,
+		Source:       smartctlCommand,
+		Model:        out.ModelName,
+		Serial:       out.SerialNumber,
+		Firmware:     out.FirmwareVersion,
+		Capacity:     out.UserCapacity.Bytes,
+		Health:       smartHealth(out),
+		Temperature:  out.Temperature.Current,
+		PowerOnHours: out.PowerOnTime.Hours,
+		PowerCycles:  out.PowerCycleCount,
+	}
+	if out.Device.Name != "" {
+		dev.Name = filepath.Base(out.Device.Name)
+	}
+
+	if mode := smartctlPowerMode(out); mode != "" {
+		dev.Skipped = mode
+		return dev, nil
+	}
+	if out.Smartctl.ExitStatus&(smartExitCommandLine|smartExitOpenFailed) != 0 {
+		dev.Error = smartctlError(out)
+	}
+
+	for _, attr := range out.ATASmartAttributes.Table {
+		switch attr.ID {
+		case smartAttrReallocated:
+			dev.ReallocatedSectors = attr.Raw.Value
+		case smartAttrPending:
+			dev.PendingSectors = attr.Raw.Value
+		case smartAttrUncorrectable:
+			dev.Uncorrectable = attr.Raw.Value
+		}
+	}
+
+	if health := out.NVMeHealth; health != nil {
+		percentageUsed := health.PercentageUsed
+		availableSpare := health.AvailableSpare
+		dev.PercentageUsed = &percentageUsed
+		dev.AvailableSpare = &availableSpare
+		dev.CriticalWarning = health.CriticalWarning
+		dev.MediaErrors = health.MediaErrors
+		dev.UnsafeShutdowns = health.UnsafeShutdowns
+		dev.DataRead = health.DataUnitsRead * nvmeDataUnitSize
+		dev.DataWritten = health.DataUnitsWritten * nvmeDataUnitSize
+	}
+
+	return dev, nil
+}
+
+// smartHealth combines the overall self-assessment with the NVMe critical warning bits
+func smartHealth(out smartctlOutput) string {
+	switch {
+	case out.SmartStatus == nil:
+		return "unknown"
+	case !out.SmartStatus.Passed:
+		return "failing"
+	case out.NVMeHealth != nil && out.NVMeHealth.CriticalWarning != 0:
+		return "warning"
+	default:
+		return "passed"
+	}
+}
+
+func smartctlError(out smartctlOutput) string {
+	var messages []string
+	for _, msg := range out.Smartctl.Messages {
+		if msg.Severity == "error" {
+			messages = append(messages, msg.String)
+		}
+	}
+	if len(messages) == 0 {
+		return fmt.Sprintf("smartctl exited with status %d", out.Smartctl.ExitStatus)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// smartctlPowerMode returns the low-power mode, such as standby or sleep,
+// that made -n standby skip the device
+func smartctlPowerMode(out smartctlOutput) string {
+	for _, msg := range out.Smartctl.Messages {
+		if m := smartPowerModeRegex.FindStringSubmatch(msg.String); m != nil {
+			return strings.ToLower(m[1])
+		}
+	}
+	return ""
+}
+
+func nvmeController(name string) string {
+	return nvmeControllerRegex.FindString(name)
+}
+
+func (self *GopsUtil) nvmeHealthByController() map[string]*models.SmartDevice {
+	devices, err := self.readNVMeHealth()
+	if err != nil {
+		return map[string]*models.SmartDevice{}
+	}
+
+	byController := make(map[string]*models.SmartDevice, len(devices))
+	for _, dev := range devices {
+		byController[dev.Name] = dev
+	}
+	return byController
+}
@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readNVMeHealth() ([]*models.SmartDevice, error) {
+	return nil, fmt.Errorf("NVMe sysfs health is not supported on darwin")
+}
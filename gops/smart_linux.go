@@ -0,0 +1,84 @@
+//go:build linux
+
+package gops
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const nvmeClassSysfsPath = "/sys/class/nvme"
+
+func (self *GopsUtil) readNVMeHealth() ([]*models.SmartDevice, error) {
+	return self.readNVMeHealthFrom(nvmeClassSysfsPath)
+}
+
+// readNVMeHealthFrom covers what the kernel exposes without smartctl:
+// controller identity, namespace sizes and the composite temperature
+func (self *GopsUtil) readNVMeHealthFrom(root string) ([]*models.SmartDevice, error) {
+	entries, err := self.fs.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read NVMe controllers: %w", err)
+	}
+
+	var devices []*models.SmartDevice
+	for _, entry := range entries {
+		name := entry.Name()
+		if nvmeController(name) != name {
+			continue
+		}
+		dir := filepath.Join(root, name)
+
+		dev := &models.SmartDevice{
+			Name:     name,
+			Path:     "/dev/" + name,
+			Type:     "nvme",
+			Protocol: "NVMe",
+			Source:   "sysfs",
+			Model:    self.readSysfsString(filepath.Join(dir, "model")),
+			Serial:   self.readSysfsString(filepath.Join(dir, "serial")),
+			Firmware: self.readSysfsString(filepath.Join(dir, "firmware_rev")),
+			Health:   "unknown",
+		}
+
+		for _, ns := range self.listSysfsDir(dir) {
+			if !strings.HasPrefix(ns, name+"n") {
+				continue
+			}
+			if sectors, err := self.readSysfsUint(filepath.Join(dir, ns, "size")); err == nil {
+				dev.Capacity += sectors * diskSectorSize
+			}
+		}
+
+		dev.Temperature = self.readNVMeTemperature(dir)
+		devices = append(devices, dev)
+	}
+
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("no NVMe controllers found in %s", root)
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		return trailingNumber(devices[i].Name) < trailingNumber(devices[j].Name)
+	})
+	return devices, nil
+}
+
+// The nvme hwmon sits on the controller on newer kernels and on the PCI device on older ones
+func (self *GopsUtil) readNVMeTemperature(controllerDir string) float64 {
+	for _, dir := range []string{controllerDir, filepath.Join(controllerDir, "device", "hwmon")} {
+		for _, hwmon := range self.listSysfsDir(dir) {
+			if !strings.HasPrefix(hwmon, "hwmon") {
+				continue
+			}
+			if milli, err := self.readSysfsUint(filepath.Join(dir, hwmon, "temp1_input")); err == nil {
+				return float64(milli) / 1000
+			}
+		}
+	}
+	return 0
+}
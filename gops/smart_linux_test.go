@@ -0,0 +1,50 @@
+//go:build linux
+
+package gops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadNVMeHealthFrom(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/class/nvme/nvme0/model":                           "Samsung SSD 980 PRO 1TB                 ",
+		"/sys/class/nvme/nvme0/serial":                          "S5GXNF0R000000",
+		"/sys/class/nvme/nvme0/firmware_rev":                    "5B2QGXA7",
+		"/sys/class/nvme/nvme0/nvme0n1/size":                    "1953525168",
+		"/sys/class/nvme/nvme0/hwmon3/temp1_input":              "41850",
+		"/sys/class/nvme/nvme1/model":                           "WD Blue SN570",
+		"/sys/class/nvme/nvme1/device/hwmon/hwmon4/temp1_input": "38000",
+		"/sys/class/nvme/nvme-subsystem/ignored":                "",
+	})
+
+	devices, err := gops.readNVMeHealth()
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+
+	assert.Equal(t, "nvme0", devices[0].Name)
+	assert.Equal(t, "/dev/nvme0", devices[0].Path)
+	assert.Equal(t, "sysfs", devices[0].Source)
+	assert.Equal(t, "unknown", devices[0].Health)
+	assert.Equal(t, "Samsung SSD 980 PRO 1TB", devices[0].Model)
+	assert.Equal(t, "5B2QGXA7", devices[0].Firmware)
+	assert.Equal(t, uint64(1953525168*512), devices[0].Capacity)
+	assert.InDelta(t, 41.85, devices[0].Temperature, 0.001)
+	assert.Nil(t, devices[0].PercentageUsed)
+
+	assert.Equal(t, "nvme1", devices[1].Name)
+	assert.Equal(t, 38.0, devices[1].Temperature)
+}
+
+func TestReadNVMeHealthFromEmpty(t *testing.T) {
+	gops, fsys := newFixtureGops(nil)
+	_, err := gops.readNVMeHealth()
+	assert.Error(t, err)
+
+	fsys.mkdir(nvmeClassSysfsPath)
+	_, err = gops.readNVMeHealth()
+	assert.Error(t, err)
+}
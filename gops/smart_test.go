@@ -0,0 +1,184 @@
+package gops
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AvengeMedia/dgop/gops/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const smartctlScanJSON = `{
+  "smartctl": {"exit_status": 0},
+  "devices": [
+    {"name": "/dev/sda", "info_name": "/dev/sda [SAT]", "type": "sat", "protocol": "ATA"},
+    {"name": "/dev/nvme0", "info_name": "/dev/nvme0", "type": "nvme", "protocol": "NVMe"},
+    {"name": "/dev/sdb", "info_name": "/dev/sdb [SAT]", "type": "sat", "protocol": "ATA"}
+  ]
+}`
+
+const smartctlATAJSON = `{
+  "smartctl": {"exit_status": 0},
+  "device": {"name": "/dev/sda", "info_name": "/dev/sda [SAT]", "type": "sat", "protocol": "ATA"},
+  "model_name": "WDC WD40EFRX-68N32N0",
+  "serial_number": "WD-WCC7K0000000",
+  "firmware_version": "82.00A82",
+  "user_capacity": {"blocks": 7814037168, "bytes": 4000787030016},
+  "smart_status": {"passed": true},
+  "ata_smart_attributes": {
+    "revision": 16,
+    "table": [
+      {"id": 5, "name": "Reallocated_Sector_Ct", "value": 200, "raw": {"value": 8, "string": "8"}},
+      {"id": 9, "name": "Power_On_Hours", "value": 38, "raw": {"value": 45512, "string": "45512"}},
+      {"id": 194, "name": "Temperature_Celsius", "value": 114, "raw": {"value": 38654705700, "string": "36 (Min/Max 20/45)"}},
+      {"id": 197, "name": "Current_Pending_Sector", "value": 200, "raw": {"value": 2, "string": "2"}},
+      {"id": 198, "name": "Offline_Uncorrectable", "value": 100, "raw": {"value": 1, "string": "1"}}
+    ]
+  },
+  "power_on_time": {"hours": 45512},
+  "power_cycle_count": 112,
+  "temperature": {"current": 36}
+}`
+
+const smartctlNVMeJSON = `{
+  "smartctl": {"exit_status": 0},
+  "device": {"name": "/dev/nvme0", "info_name": "/dev/nvme0", "type": "nvme", "protocol": "NVMe"},
+  "model_name": "Samsung SSD 980 PRO 1TB",
+  "serial_number": "S5GXNF0R000000",
+  "firmware_version": "5B2QGXA7",
+  "user_capacity": {"blocks": 1953525168, "bytes": 1000204886016},
+  "smart_status": {"passed": true, "nvme": {"value": 4}},
+  "nvme_smart_health_information_log": {
+    "critical_warning": 4,
+    "temperature": 41,
+    "available_spare": 100,
+    "available_spare_threshold": 10,
+    "percentage_used": 7,
+    "data_units_read": 20000000,
+    "data_units_written": 30000000,
+    "power_cycles": 1200,
+    "power_on_hours": 8760,
+    "unsafe_shutdowns": 53,
+    "media_errors": 0,
+    "num_err_log_entries": 0
+  },
+  "temperature": {"current": 41},
+  "power_cycle_count": 1200,
+  "power_on_time": {"hours": 8760}
+}`
+
+const smartctlOpenFailedJSON = `{
+  "smartctl": {
+    "exit_status": 2,
+    "messages": [
+      {"string": "Smartctl open device: /dev/sdb failed: Permission denied", "severity": "error"}
+    ]
+  },
+  "device": {"name": "/dev/sdb", "info_name": "/dev/sdb", "type": "sat", "protocol": "ATA"}
+}`
+
+func newSmartTestGops(t *testing.T) (*GopsUtil, *mocks.MockCommandExecutor) {
+	cmd := mocks.NewMockCommandExecutor(t)
+	return NewGopsUtilWithProviders(nil, nil, nil, nil, nil, nil, nil, newFixtureFS(nil), cmd), cmd
+}
+
+func TestGetSmart(t *testing.T) {
+	gops, cmd := newSmartTestGops(t)
+
+	exitStatus2 := errors.New("exit status 2")
+	cmd.EXPECT().Execute("smartctl", "--json", "--scan").Return([]byte(smartctlScanJSON), nil).Once()
+	cmd.EXPECT().Execute("smartctl", "--json", "-a", "-n", "standby", "-d", "sat", "/dev/sda").Return([]byte(smartctlATAJSON), nil).Once()
+	cmd.EXPECT().Execute("smartctl", "--json", "-a", "-n", "standby", "-d", "nvme", "/dev/nvme0").Return([]byte(smartctlNVMeJSON), nil).Once()
+	cmd.EXPECT().Execute("smartctl", "--json", "-a", "-n", "standby", "-d", "sat", "/dev/sdb").Return([]byte(smartctlOpenFailedJSON), exitStatus2).Once()
+
+	devices, err := gops.GetSmart()
+	require.NoError(t, err)
+	require.Len(t, devices, 3)
+
+	ata := devices[0]
+	assert.Equal(t, "sda", ata.Name)
+	assert.Equal(t, "smartctl", ata.Source)
+	assert.Equal(t, "passed", ata.Health)
+	assert.Equal(t, "WDC WD40EFRX-68N32N0", ata.Model)
+	assert.Equal(t, uint64(4000787030016), ata.Capacity)
+	assert.Equal(t, 36.0, ata.Temperature)
+	assert.Equal(t, uint64(45512), ata.PowerOnHours)
+	assert.Equal(t, uint64(112), ata.PowerCycles)
+	assert.Equal(t, uint64(8), ata.ReallocatedSectors)
+	assert.Equal(t, uint64(2), ata.PendingSectors)
+	assert.Equal(t, uint64(1), ata.Uncorrectable)
+	assert.Nil(t, ata.PercentageUsed)
+	assert.Empty(t, ata.Error)
+
+	nvme := devices[1]
+	assert.Equal(t, "nvme0", nvme.Name)
+	assert.Equal(t, "NVMe", nvme.Protocol)
+	assert.Equal(t, "warning", nvme.Health)
+	assert.Equal(t, 4, nvme.CriticalWarning)
+	require.NotNil(t, nvme.PercentageUsed)
+	assert.Equal(t, 7, *nvme.PercentageUsed)
+	assert.Equal(t, 100, *nvme.AvailableSpare)
+	assert.Equal(t, uint64(53), nvme.UnsafeShutdowns)
+	assert.Equal(t, uint64(0), nvme.MediaErrors)
+	assert.Equal(t, uint64(30000000*nvmeDataUnitSize), nvme.DataWritten)
+	assert.Equal(t, uint64(8760), nvme.PowerOnHours)
+
+	failed := devices[2]
+	assert.Equal(t, "sdb", failed.Name)
+	assert.Equal(t, "unknown", failed.Health)
+	assert.Equal(t, "Smartctl open device: /dev/sdb failed: Permission denied", failed.Error)
+}
+
+func TestGetSmartDeviceCommandFails(t *testing.T) {
+	gops, cmd := newSmartTestGops(t)
+
+	cmd.EXPECT().Execute("smartctl", "--json", "--scan").
+		Return([]byte(`{"devices": [{"name": "/dev/sda", "type": "sat"}]}`), nil).Once()
+	cmd.EXPECT().Execute("smartctl", "--json", "-a", "-n", "standby", "-d", "sat", "/dev/sda").
+		Return(nil, errors.New("signal: killed")).Once()
+
+	devices, err := gops.GetSmart()
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, "sda", devices[0].Name)
+	assert.Equal(t, "/dev/sda", devices[0].Path)
+	assert.Equal(t, "unknown", devices[0].Health)
+	assert.Equal(t, "signal: killed", devices[0].Error)
+}
+
+func TestParseSmartctlOutputFailing(t *testing.T) {
+	dev, err := parseSmartctlOutput([]byte(`{
+		"smartctl": {"exit_status": 8},
+		"device": {"name": "/dev/sdc", "type": "sat", "protocol": "ATA"},
+		"smart_status": {"passed": false}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "failing", dev.Health)
+	assert.Empty(t, dev.Error)
+
+	_, err = parseSmartctlOutput([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestNVMeController(t *testing.T) {
+	assert.Equal(t, "nvme0", nvmeController("nvme0"))
+	assert.Equal(t, "nvme1", nvmeController("nvme1n1"))
+	assert.Equal(t, "nvme12", nvmeController("nvme12n1p2"))
+	assert.Equal(t, "", nvmeController("sda"))
+}
+
+func TestParseSmartctlOutputStandby(t *testing.T) {
+	dev, err := parseSmartctlOutput([]byte(`{
+		"smartctl": {
+			"exit_status": 2,
+			"messages": [{"string": "Device is in STANDBY mode, exit(2)", "severity": "information"}]
+		},
+		"device": {"name": "/dev/sdb", "type": "sat", "protocol": "ATA"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "sdb", dev.Name)
+	assert.Equal(t, "standby", dev.Skipped)
+	assert.Equal(t, "unknown", dev.Health)
+	assert.Empty(t, dev.Error)
+}
@@ -0,0 +1,407 @@
+package gops
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/internal/log"
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// Normalised health shared by md arrays, btrfs filesystems and zpools
+const (
+	PoolHealthy  = "healthy"
+	PoolDegraded = "degraded"
+	PoolFaulted  = "faulted"
+)
+
+var (
+	mdStatusRegex   = regexp.MustCompile(`\[(\d+)/(\d+)\]\s+\[([U_]+)\]`)
+	mdProgressRegex = regexp.MustCompile(`(resync|recovery|reshape|check|repair)\s*=\s*([\d.]+)%`)
+	mdPendingRegex  = regexp.MustCompile(`(resync|recovery|reshape|check|repair)\s*=\s*(DELAYED|PENDING)`)
+	mdFinishRegex   = regexp.MustCompile(`finish=([\d.]+)min`)
+	mdSpeedRegex    = regexp.MustCompile(`speed=(\d+)K/sec`)
+	mdMemberRegex   = regexp.MustCompile(`^(\S+)\[(\d+)\]((?:\([A-Z]\))*)$`)
+
+	zpoolFieldRegex    = regexp.MustCompile(`^\s*(pool|state|status|action|see|scan|remove|checkpoint|config|errors):\s*(.*)$`)
+	zpoolVdevRegex     = regexp.MustCompile(`^(mirror|raidz\d?|draid\d?[^-]*|replacing|spare)-\d+$`)
+	zpoolProgressRegex = regexp.MustCompile(`([\d.]+)% done`)
+	zpoolETARegex      = regexp.MustCompile(`(?:(\d+) days? )?(\d+):(\d\d):(\d\d) to go`)
+	zpoolSpeedRegex    = regexp.MustCompile(`issued at (\d+)/s`)
+)
+
+var mdMemberFlags = map[byte]string{
+	'F': "faulty",
+	'S': "spare",
+	'W': "write-mostly",
+	'R': "replacement",
+	'J': "journal",
+}
+
+func (self *GopsUtil) GetStoragePools() ([]*models.StoragePool, error) {
+	pools := self.readLocalStoragePools()
+
+	if self.zfsAvailable() {
+		zpools, err := self.readZpools()
+		if err != nil && !errors.Is(err, exec.ErrNotFound) {
+			log.Warn("failed to read zpool status", "error", err)
+		}
+		pools = append(pools, zpools...)
+	}
+
+	if pools == nil {
+		pools = []*models.StoragePool{}
+	}
+	return pools, nil
+}
+
+func (self *GopsUtil) readZpools() ([]*models.StoragePool, error) {
+	out, err := self.cmd.Execute("zpool", "status", "-p")
+	if err != nil && len(out) == 0 {
+		return nil, fmt.Errorf("failed to run zpool status: %w", err)
+	}
+	return parseZpoolStatus(string(out)), nil
+}
+
+// poolStatus maps missing members against the redundancy of the layout
+func poolStatus(missing, tolerance int, degraded bool) string {
+	switch {
+	case missing > tolerance:
+		return PoolFaulted
+	case missing > 0 || degraded:
+		return PoolDegraded
+	default:
+		return PoolHealthy
+	}
+}
+
+func parseMdstat(content string) []*models.StoragePool {
+	var pools []*models.StoragePool
+	var current *models.StoragePool
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "Personalities") || strings.HasPrefix(trimmed, "unused devices") {
+			continue
+		}
+
+		if line[0] != ' ' && line[0] != '\t' && strings.Contains(line, " : ") {
+			current = parseMdHeader(line)
+			pools = append(pools, current)
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		fields := strings.Fields(trimmed)
+		if len(fields) > 1 && fields[1] == "blocks" {
+			if blocks, err := strconv.ParseUint(fields[0], 10, 64); err == nil {
+				current.Size = blocks * 1024
+			}
+		}
+		if m := mdStatusRegex.FindStringSubmatch(trimmed); m != nil {
+			current.Devices, _ = strconv.Atoi(m[1])
+			current.Working, _ = strconv.Atoi(m[2])
+		}
+		if m := mdProgressRegex.FindStringSubmatch(trimmed); m != nil {
+			current.Operation = m[1]
+			current.Progress, _ = strconv.ParseFloat(m[2], 64)
+			if f := mdFinishRegex.FindStringSubmatch(trimmed); f != nil {
+				minutes, _ := strconv.ParseFloat(f[1], 64)
+				current.ETASeconds = minutes * 60
+			}
+			if s := mdSpeedRegex.FindStringSubmatch(trimmed); s != nil {
+				kb, _ := strconv.ParseUint(s[1], 10, 64)
+				current.Speed = kb * 1024
+			}
+		} else if m := mdPendingRegex.FindStringSubmatch(trimmed); m != nil {
+			current.Operation = m[1]
+			current.Message = fmt.Sprintf("%s %s", m[1], strings.ToLower(m[2]))
+		}
+	}
+
+	for _, pool := range pools {
+		finalizeMdPool(pool)
+	}
+	return pools
+}
+
+// parseMdHeader handles "md1 : active (auto-read-only) raid5 sdc1[0](F) sdd1[1]"
+func parseMdHeader(line string) *models.StoragePool {
+	name, rest, _ := strings.Cut(line, " : ")
+	pool := &models.StoragePool{
+		Name: strings.TrimSpace(name),
+		Kind: "mdraid",
+	}
+
+	type slot struct {
+		index  int
+		member models.StoragePoolMember
+	}
+	var slots []slot
+
+	for i, field := range strings.Fields(rest) {
+		if i == 0 {
+			pool.State = field
+			continue
+		}
+		if strings.HasPrefix(field, "(") {
+			pool.State += " " + field
+			continue
+		}
+
+		m := mdMemberRegex.FindStringSubmatch(field)
+		if m == nil {
+			pool.Level = field
+			continue
+		}
+
+		index, _ := strconv.Atoi(m[2])
+		member := models.StoragePoolMember{Name: m[1], State: "active", Status: PoolHealthy}
+		for j := 1; j < len(m[3]); j += 3 {
+			if state, ok := mdMemberFlags[m[3][j]]; ok {
+				member.State = state
+			}
+		}
+		if member.State == "faulty" {
+			member.Status = PoolFaulted
+		}
+		slots = append(slots, slot{index: index, member: member})
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].index < slots[j].index })
+	pool.Members = make([]models.StoragePoolMember, 0, len(slots))
+	for _, s := range slots {
+		pool.Members = append(pool.Members, s.member)
+	}
+	return pool
+}
+
+func finalizeMdPool(pool *models.StoragePool) {
+	faulty := 0
+	for _, member := range pool.Members {
+		if member.Status == PoolFaulted {
+			faulty++
+		}
+	}
+
+	if strings.HasPrefix(pool.State, "inactive") {
+		pool.Status = PoolFaulted
+		return
+	}
+
+	// raid0 and linear have no [n/m] line, a failed member takes the array down
+	if pool.Devices == 0 {
+		pool.Devices = len(pool.Members) - faulty
+		pool.Working = pool.Devices
+		if faulty > 0 {
+			pool.Status = PoolFaulted
+			return
+		}
+	}
+
+	pool.Status = poolStatus(pool.Devices-pool.Working, mdRedundancy(pool.Level, pool.Devices), faulty > 0)
+	if pool.Working == 0 {
+		pool.Status = PoolFaulted
+	}
+}
+
+func mdRedundancy(level string, devices int) int {
+	switch level {
+	case "raid1":
+		return devices - 1
+	case "raid4", "raid5":
+		return 1
+	case "raid6":
+		return 2
+	case "raid10":
+		// Best case, losing both halves of a mirror pair still kills the array
+		return devices / 2
+	default:
+		return 0
+	}
+}
+
+func btrfsRedundancy(profile string) int {
+	switch profile {
+	case "raid1", "raid10", "raid5":
+		return 1
+	case "raid1c3", "raid6":
+		return 2
+	case "raid1c4":
+		return 3
+	default:
+		return 0
+	}
+}
+
+// applyBtrfsErrorStats folds the five per-device counters into read, write and checksum
+func applyBtrfsErrorStats(member *models.StoragePoolMember, stats map[string]uint64) {
+	member.ReadErrors = stats["read_errs"]
+	member.WriteErrors = stats["write_errs"] + stats["flush_errs"]
+	member.ChecksumErrors = stats["corruption_errs"] + stats["generation_errs"]
+}
+
+func finalizeBtrfsPool(pool *models.StoragePool) {
+	missing := 0
+	for _, member := range pool.Members {
+		pool.Errors += member.ReadErrors + member.WriteErrors + member.ChecksumErrors
+		if member.Status == PoolFaulted {
+			missing++
+		}
+	}
+	pool.Devices = len(pool.Members)
+	pool.Working = pool.Devices - missing
+	pool.Status = poolStatus(missing, btrfsRedundancy(pool.Level), false)
+}
+
+func zfsStatus(state string) string {
+	switch state {
+	case "ONLINE", "AVAIL", "INUSE":
+		return PoolHealthy
+	case "FAULTED", "UNAVAIL", "SUSPENDED":
+		return PoolFaulted
+	default:
+		return PoolDegraded
+	}
+}
+
+func parseZpoolStatus(content string) []*models.StoragePool {
+	var pools []*models.StoragePool
+	var pool *models.StoragePool
+	var section string
+	poolIndent := -1
+
+	for _, line := range strings.Split(content, "\n") {
+		if m := zpoolFieldRegex.FindStringSubmatch(line); m != nil {
+			key, value := m[1], strings.TrimSpace(m[2])
+			if key == "pool" {
+				pool = &models.StoragePool{Name: value, Kind: "zfs", Members: []models.StoragePoolMember{}}
+				pools = append(pools, pool)
+				poolIndent = -1
+			}
+			if pool == nil {
+				continue
+			}
+
+			section = key
+			switch key {
+			case "state":
+				pool.State = value
+			case "status":
+				pool.Message = value
+			case "scan":
+				parseZpoolScan(pool, value)
+			case "errors":
+				if value != "" && value != "No known data errors" {
+					pool.Message = strings.TrimSpace(pool.Message + " " + value)
+				}
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if pool == nil || trimmed == "" {
+			continue
+		}
+
+		switch section {
+		case "status":
+			pool.Message += " " + trimmed
+		case "scan":
+			parseZpoolScan(pool, trimmed)
+		case "config":
+			parseZpoolConfigRow(pool, line, &poolIndent)
+		}
+	}
+
+	for _, pool := range pools {
+		working := 0
+		for _, member := range pool.Members {
+			pool.Errors += member.ReadErrors + member.WriteErrors + member.ChecksumErrors
+			if member.Status != PoolFaulted {
+				working++
+			}
+		}
+		pool.Devices = len(pool.Members)
+		pool.Working = working
+
+		pool.Status = zfsStatus(pool.State)
+		if pool.Status == PoolHealthy && pool.Errors > 0 {
+			pool.Status = PoolDegraded
+		}
+	}
+	return pools
+}
+
+func parseZpoolScan(pool *models.StoragePool, text string) {
+	if op, _, ok := strings.Cut(text, " in progress"); ok {
+		pool.Operation = op
+	}
+	if pool.Operation == "" {
+		return
+	}
+
+	if m := zpoolProgressRegex.FindStringSubmatch(text); m != nil {
+		pool.Progress, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if m := zpoolETARegex.FindStringSubmatch(text); m != nil {
+		days, _ := strconv.Atoi(m[1])
+		hours, _ := strconv.Atoi(m[2])
+		minutes, _ := strconv.Atoi(m[3])
+		seconds, _ := strconv.Atoi(m[4])
+		pool.ETASeconds = float64(((days*24+hours)*60+minutes)*60 + seconds)
+	}
+	if m := zpoolSpeedRegex.FindStringSubmatch(text); m != nil {
+		pool.Speed, _ = strconv.ParseUint(m[1], 10, 64)
+	}
+}
+
+// parseZpoolConfigRow keeps leaf devices, using indentation relative to the
+// pool row to tell vdevs and section headers (logs, cache, spares) apart
+func parseZpoolConfigRow(pool *models.StoragePool, line string, poolIndent *int) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] == "NAME" {
+		return
+	}
+
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	if *poolIndent < 0 {
+		if fields[0] == pool.Name {
+			*poolIndent = indent
+		}
+		return
+	}
+	if indent <= *poolIndent {
+		return
+	}
+
+	name := fields[0]
+	if m := zpoolVdevRegex.FindStringSubmatch(name); m != nil {
+		if pool.Level == "" && m[1] != "replacing" && m[1] != "spare" {
+			pool.Level = m[1]
+		}
+		return
+	}
+	if pool.Level == "" && indent-*poolIndent <= 2 {
+		pool.Level = "stripe"
+	}
+
+	member := models.StoragePoolMember{
+		Name:   name,
+		State:  fields[1],
+		Status: zfsStatus(fields[1]),
+	}
+	if len(fields) >= 5 {
+		member.ReadErrors, _ = strconv.ParseUint(fields[2], 10, 64)
+		member.WriteErrors, _ = strconv.ParseUint(fields[3], 10, 64)
+		member.ChecksumErrors, _ = strconv.ParseUint(fields[4], 10, 64)
+	}
+	pool.Members = append(pool.Members, member)
+}
@@ -0,0 +1,18 @@
+//go:build darwin
+
+package gops
+
+import (
+	"github.com/AvengeMedia/dgop/models"
+)
+
+// macOS has no md or btrfs, only OpenZFS when installed
+func (self *GopsUtil) readLocalStoragePools() []*models.StoragePool {
+	return nil
+}
+
+// There is no module to look for, so zpool is simply tried through the
+// command executor and a missing binary is treated as no ZFS
+func (self *GopsUtil) zfsAvailable() bool {
+	return true
+}
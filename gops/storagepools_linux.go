@@ -0,0 +1,109 @@
+//go:build linux
+
+package gops
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/AvengeMedia/dgop/internal/log"
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const (
+	mdstatPath     = "/proc/mdstat"
+	btrfsSysfsPath = "/sys/fs/btrfs"
+	zfsModulePath  = "/sys/module/zfs"
+)
+
+func (self *GopsUtil) readLocalStoragePools() []*models.StoragePool {
+	var pools []*models.StoragePool
+
+	if data, err := self.fs.ReadFile(mdstatPath); err == nil {
+		pools = append(pools, parseMdstat(string(data))...)
+	}
+
+	btrfs, err := self.readBtrfsPoolsFrom(btrfsSysfsPath)
+	if err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to read btrfs filesystems", "error", err)
+	}
+	return append(pools, btrfs...)
+}
+
+func (self *GopsUtil) zfsAvailable() bool {
+	_, err := self.fs.Stat(zfsModulePath)
+	return err == nil
+}
+
+// readBtrfsPoolsFrom walks /sys/fs/btrfs/<uuid>, taking per-device state and
+// error counters from devinfo/<devid> (5.9+) and the data profile from allocation
+func (self *GopsUtil) readBtrfsPoolsFrom(root string) ([]*models.StoragePool, error) {
+	entries, err := self.fs.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []*models.StoragePool
+	for _, entry := range entries {
+		// features/ describes the module, every other directory is a filesystem
+		if entry.Name() == "features" || !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+
+		pool := &models.StoragePool{
+			Name:  self.readSysfsString(filepath.Join(dir, "label")),
+			Kind:  "btrfs",
+			State: "mounted",
+		}
+		if pool.Name == "" {
+			pool.Name = entry.Name()
+		}
+
+		for _, profile := range self.listSysfsDir(filepath.Join(dir, "allocation", "data")) {
+			if info, err := self.fs.Stat(filepath.Join(dir, "allocation", "data", profile)); err == nil && info.IsDir() {
+				pool.Level = profile
+				break
+			}
+		}
+
+		for _, dev := range self.listSysfsDir(filepath.Join(dir, "devices")) {
+			if sectors, err := self.readSysfsUint(filepath.Join(dir, "devices", dev, "size")); err == nil {
+				pool.Size += sectors * diskSectorSize
+			}
+		}
+
+		devids := self.listSysfsDir(filepath.Join(dir, "devinfo"))
+		sort.Slice(devids, func(i, j int) bool { return trailingNumber(devids[i]) < trailingNumber(devids[j]) })
+
+		pool.Members = make([]models.StoragePoolMember, 0, len(devids))
+		for _, devid := range devids {
+			devDir := filepath.Join(dir, "devinfo", devid)
+			member := models.StoragePoolMember{
+				Name:   "devid " + devid,
+				State:  "online",
+				Status: PoolHealthy,
+			}
+			if data, err := self.fs.ReadFile(filepath.Join(devDir, "error_stats")); err == nil {
+				applyBtrfsErrorStats(&member, parseFlatKeyed(string(data)))
+			}
+
+			// error_stats are lifetime counters that survive the fault being
+			// fixed, so health only follows the device state
+			switch {
+			case self.readSysfsString(filepath.Join(devDir, "missing")) == "1":
+				member.State = "missing"
+				member.Status = PoolFaulted
+			case self.readSysfsString(filepath.Join(devDir, "replace_target")) == "1":
+				member.State = "replace-target"
+			}
+			pool.Members = append(pool.Members, member)
+		}
+
+		finalizeBtrfsPool(pool)
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
@@ -0,0 +1,97 @@
+//go:build linux
+
+package gops
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/AvengeMedia/dgop/gops/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBtrfsPoolsFrom(t *testing.T) {
+	fs := btrfsSysfsPath + "/5b3c1c3e-2b1f-4d8e-9a43-0e7c2a1d9f10"
+	gops, _ := newFixtureGops(map[string]string{
+		btrfsSysfsPath + "/features/raid1c34":                          "0",
+		fs + "/label":                                                  "data",
+		fs + "/allocation/data/raid1/total_bytes":                      "1073741824",
+		fs + "/allocation/data/bytes_used":                             "0",
+		fs + "/devices/sda/size":                                       "2000",
+		fs + "/devices/sdb/size":                                       "2000",
+		fs + "/devinfo/1/missing":                                      "0",
+		fs + "/devinfo/1/error_stats":                                  "write_errs 0\nread_errs 0\nflush_errs 0\ncorruption_errs 0\ngeneration_errs 0\n",
+		fs + "/devinfo/2/missing":                                      "0",
+		fs + "/devinfo/2/error_stats":                                  "write_errs 1\nread_errs 3\nflush_errs 2\ncorruption_errs 4\ngeneration_errs 0\n",
+		fs + "/devinfo/10/missing":                                     "1",
+		btrfsSysfsPath + "/0a0a0a0a-0000-0000-0000-000000000000/label": "",
+	})
+
+	pools, err := gops.readBtrfsPoolsFrom(btrfsSysfsPath)
+	require.NoError(t, err)
+	require.Len(t, pools, 2)
+
+	// Unlabelled filesystems fall back to their UUID
+	assert.Equal(t, "0a0a0a0a-0000-0000-0000-000000000000", pools[0].Name)
+
+	data := pools[1]
+	assert.Equal(t, "data", data.Name)
+	assert.Equal(t, "btrfs", data.Kind)
+	assert.Equal(t, "raid1", data.Level)
+	assert.Equal(t, uint64(4000*512), data.Size)
+	assert.Equal(t, PoolDegraded, data.Status)
+	assert.Equal(t, uint64(10), data.Errors)
+	assert.Equal(t, 3, data.Devices)
+	assert.Equal(t, 2, data.Working)
+
+	require.Len(t, data.Members, 3)
+	assert.Equal(t, "devid 1", data.Members[0].Name)
+	assert.Equal(t, PoolHealthy, data.Members[0].Status)
+	assert.Equal(t, uint64(3), data.Members[1].ReadErrors)
+	assert.Equal(t, uint64(3), data.Members[1].WriteErrors)
+	assert.Equal(t, uint64(4), data.Members[1].ChecksumErrors)
+	assert.Equal(t, PoolHealthy, data.Members[1].Status, "lifetime counters alone don't degrade a device")
+	assert.Equal(t, "devid 10", data.Members[2].Name)
+	assert.Equal(t, "missing", data.Members[2].State)
+	assert.Equal(t, PoolFaulted, data.Members[2].Status)
+}
+
+func TestReadBtrfsPoolsFromOldErrorsStayHealthy(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/fs/btrfs/fs/label":                       "backup",
+		"/sys/fs/btrfs/fs/allocation/data/raid1/total": "0",
+		"/sys/fs/btrfs/fs/devinfo/1/missing":           "0",
+		"/sys/fs/btrfs/fs/devinfo/1/error_stats":       "write_errs 0\nread_errs 7\nflush_errs 0\ncorruption_errs 2\ngeneration_errs 0\n",
+		"/sys/fs/btrfs/fs/devinfo/2/missing":           "0",
+	})
+
+	pools, err := gops.readBtrfsPoolsFrom(btrfsSysfsPath)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	assert.Equal(t, PoolHealthy, pools[0].Status)
+	assert.Equal(t, uint64(9), pools[0].Errors)
+	assert.Equal(t, uint64(7), pools[0].Members[0].ReadErrors)
+}
+
+func TestGetStoragePoolsZpoolNotInstalled(t *testing.T) {
+	fsys := newFixtureFS(nil)
+	fsys.mkdir(zfsModulePath)
+	cmd := mocks.NewMockCommandExecutor(t)
+	gops := NewGopsUtilWithProviders(nil, nil, nil, nil, nil, nil, nil, fsys, cmd)
+
+	cmd.EXPECT().Execute("zpool", "status", "-p").Return(nil, &exec.Error{Name: "zpool", Err: exec.ErrNotFound}).Once()
+
+	pools, err := gops.GetStoragePools()
+	require.NoError(t, err)
+	for _, pool := range pools {
+		assert.NotEqual(t, "zfs", pool.Kind)
+	}
+}
+
+func TestReadBtrfsPoolsFromMissing(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	_, err := gops.readBtrfsPoolsFrom(btrfsSysfsPath)
+	assert.Error(t, err)
+}
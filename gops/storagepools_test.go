@@ -0,0 +1,219 @@
+package gops
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mdstatFixture = `Personalities : [raid1] [raid6] [raid5] [raid4] [raid0]
+md0 : active raid1 sdb1[1] sda1[0]
+      976630464 blocks super 1.2 [2/2] [UU]
+      bitmap: 0/8 pages [0KB], 65536KB chunk
+
+md1 : active raid5 sde1[3] sdd1[1] sdc1[0](F)
+      1953258496 blocks super 1.2 level 5, 512k chunk, algorithm 2 [3/2] [_UU]
+      [===>.................]  recovery = 15.4% (150635520/976629248) finish=82.1min speed=167650K/sec
+
+md2 : active raid0 sdg1[1](F) sdf1[0]
+      1953260544 blocks super 1.2 512k chunks
+
+md3 : inactive sdh1[0](S)
+      976630488 blocks super 1.2
+
+md4 : active (auto-read-only) raid6 sdi1[0] sdj1[1] sdk1[2] sdl1[3]
+      1953260544 blocks super 1.2 level 6, 512k chunk, algorithm 2 [4/4] [UUUU]
+      	resync=PENDING
+
+unused devices: <none>
+`
+
+func TestParseMdstat(t *testing.T) {
+	pools := parseMdstat(mdstatFixture)
+	require.Len(t, pools, 5)
+
+	md0 := pools[0]
+	assert.Equal(t, "md0", md0.Name)
+	assert.Equal(t, "mdraid", md0.Kind)
+	assert.Equal(t, "raid1", md0.Level)
+	assert.Equal(t, "active", md0.State)
+	assert.Equal(t, PoolHealthy, md0.Status)
+	assert.Equal(t, uint64(976630464*1024), md0.Size)
+	assert.Equal(t, 2, md0.Devices)
+	assert.Equal(t, 2, md0.Working)
+	require.Len(t, md0.Members, 2)
+	assert.Equal(t, "sda1", md0.Members[0].Name)
+	assert.Equal(t, "sdb1", md0.Members[1].Name)
+
+	md1 := pools[1]
+	assert.Equal(t, PoolDegraded, md1.Status)
+	assert.Equal(t, 3, md1.Devices)
+	assert.Equal(t, 2, md1.Working)
+	assert.Equal(t, "recovery", md1.Operation)
+	assert.Equal(t, 15.4, md1.Progress)
+	assert.InDelta(t, 82.1*60, md1.ETASeconds, 0.001)
+	assert.Equal(t, uint64(167650*1024), md1.Speed)
+	require.Len(t, md1.Members, 3)
+	assert.Equal(t, models.StoragePoolMember{Name: "sdc1", State: "faulty", Status: PoolFaulted}, md1.Members[0])
+
+	md2 := pools[2]
+	assert.Equal(t, "raid0", md2.Level)
+	assert.Equal(t, PoolFaulted, md2.Status)
+
+	md3 := pools[3]
+	assert.Equal(t, "inactive", md3.State)
+	assert.Equal(t, PoolFaulted, md3.Status)
+	assert.Equal(t, "spare", md3.Members[0].State)
+
+	md4 := pools[4]
+	assert.Equal(t, "active (auto-read-only)", md4.State)
+	assert.Equal(t, "raid6", md4.Level)
+	assert.Equal(t, PoolHealthy, md4.Status)
+	assert.Equal(t, "resync", md4.Operation)
+	assert.Equal(t, "resync pending", md4.Message)
+}
+
+func TestMdRaid6Tolerance(t *testing.T) {
+	pools := parseMdstat(`md5 : active raid6 sda[0] sdb[1] sdc[2] sdd[3] sde[4]
+      100 blocks super 1.2 level 6, 512k chunk, algorithm 2 [5/2] [UU___]
+`)
+	require.Len(t, pools, 1)
+	assert.Equal(t, PoolFaulted, pools[0].Status)
+}
+
+const zpoolStatusFixture = `  pool: tank
+ state: DEGRADED
+status: One or more devices could not be used because the label is missing or
+	invalid.  Sufficient replicas exist for the pool to continue
+	functioning in a degraded state.
+action: Replace the device using 'zpool replace'.
+   see: https://openzfs.github.io/openzfs-docs/msg/ZFS-8000-4J
+  scan: resilver in progress since Sun Oct 12 02:00:00 2025
+	1234567890 scanned at 104857600/s, 234567890 issued at 52428800/s, 9876543210 total
+	345678 resilvered, 12.34% done, 01:02:03 to go
+config:
+
+	NAME        STATE     READ WRITE CKSUM
+	tank        DEGRADED     0     0     0
+	  raidz1-0  DEGRADED     0     0     0
+	    sda     ONLINE       0     0     0
+	    sdb     UNAVAIL      0     0     0  corrupted data
+	    sdc     ONLINE       0     0     2
+	logs
+	  nvme0n1p4 ONLINE       0     0     0
+	spares
+	  sdd       AVAIL
+
+errors: No known data errors
+
+  pool: rpool
+ state: ONLINE
+  scan: scrub repaired 0B in 00:01:02 with 0 errors on Sun Oct 12 00:25:03 2025
+config:
+
+	NAME         STATE     READ WRITE CKSUM
+	rpool        ONLINE       0     0     0
+	  nvme1n1p3  ONLINE       0     0     0
+
+errors: No known data errors
+`
+
+func TestParseZpoolStatus(t *testing.T) {
+	pools := parseZpoolStatus(zpoolStatusFixture)
+	require.Len(t, pools, 2)
+
+	tank := pools[0]
+	assert.Equal(t, "tank", tank.Name)
+	assert.Equal(t, "zfs", tank.Kind)
+	assert.Equal(t, "raidz1", tank.Level)
+	assert.Equal(t, "DEGRADED", tank.State)
+	assert.Equal(t, PoolDegraded, tank.Status)
+	assert.Contains(t, tank.Message, "label is missing or invalid.")
+	assert.Equal(t, "resilver", tank.Operation)
+	assert.Equal(t, 12.34, tank.Progress)
+	assert.Equal(t, float64(3723), tank.ETASeconds)
+	assert.Equal(t, uint64(52428800), tank.Speed)
+	assert.Equal(t, uint64(2), tank.Errors)
+	assert.Equal(t, 5, tank.Devices)
+	assert.Equal(t, 4, tank.Working)
+
+	require.Len(t, tank.Members, 5)
+	assert.Equal(t, "sda", tank.Members[0].Name)
+	assert.Equal(t, models.StoragePoolMember{Name: "sdb", State: "UNAVAIL", Status: PoolFaulted}, tank.Members[1])
+	assert.Equal(t, uint64(2), tank.Members[2].ChecksumErrors)
+	assert.Equal(t, "nvme0n1p4", tank.Members[3].Name)
+	assert.Equal(t, models.StoragePoolMember{Name: "sdd", State: "AVAIL", Status: PoolHealthy}, tank.Members[4])
+
+	rpool := pools[1]
+	assert.Equal(t, "stripe", rpool.Level)
+	assert.Equal(t, PoolHealthy, rpool.Status)
+	assert.Empty(t, rpool.Operation)
+	assert.Empty(t, rpool.Message)
+	require.Len(t, rpool.Members, 1)
+}
+
+func TestParseZpoolStatusChecksumErrorsOnline(t *testing.T) {
+	pools := parseZpoolStatus(`  pool: data
+ state: ONLINE
+  scan: scrub in progress since Mon Oct 13 01:00:00 2025
+	45.60% done, 1 days 02:00:00 to go
+config:
+
+	NAME        STATE     READ WRITE CKSUM
+	data        ONLINE       0     0     0
+	  mirror-0  ONLINE       0     0     0
+	    sda     ONLINE       0     0     7
+	    sdb     ONLINE       0     0     0
+
+errors: 3 data errors, use '-v' for a list
+`)
+	require.Len(t, pools, 1)
+	assert.Equal(t, "mirror", pools[0].Level)
+	assert.Equal(t, PoolDegraded, pools[0].Status)
+	assert.Equal(t, "scrub", pools[0].Operation)
+	assert.Equal(t, 45.6, pools[0].Progress)
+	assert.Equal(t, float64(26*3600), pools[0].ETASeconds)
+	assert.Equal(t, "3 data errors, use '-v' for a list", pools[0].Message)
+}
+
+func TestReadZpools(t *testing.T) {
+	gops, cmd := newSmartTestGops(t)
+
+	cmd.EXPECT().Execute("zpool", "status", "-p").Return([]byte(zpoolStatusFixture), nil).Once()
+	pools, err := gops.readZpools()
+	require.NoError(t, err)
+	assert.Len(t, pools, 2)
+
+	cmd.EXPECT().Execute("zpool", "status", "-p").Return(nil, errors.New("exec: \"zpool\": executable file not found in $PATH")).Once()
+	_, err = gops.readZpools()
+	assert.Error(t, err)
+}
+
+func TestFinalizeBtrfsPool(t *testing.T) {
+	pool := &models.StoragePool{
+		Level: "raid1",
+		Members: []models.StoragePoolMember{
+			{Name: "devid 1", State: "online", Status: PoolHealthy},
+			{Name: "devid 2", State: "missing", Status: PoolFaulted},
+		},
+	}
+	finalizeBtrfsPool(pool)
+	assert.Equal(t, PoolDegraded, pool.Status)
+	assert.Equal(t, 2, pool.Devices)
+	assert.Equal(t, 1, pool.Working)
+
+	pool.Level = "single"
+	finalizeBtrfsPool(pool)
+	assert.Equal(t, PoolFaulted, pool.Status)
+}
+
+func TestPoolStatus(t *testing.T) {
+	assert.Equal(t, PoolHealthy, poolStatus(0, 1, false))
+	assert.Equal(t, PoolDegraded, poolStatus(0, 1, true))
+	assert.Equal(t, PoolDegraded, poolStatus(1, 1, false))
+	assert.Equal(t, PoolFaulted, poolStatus(2, 1, false))
+	assert.Equal(t, PoolFaulted, poolStatus(1, 0, false))
+}
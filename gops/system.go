@@ -1,7 +1,11 @@
 package gops
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,7 +23,18 @@ type systemTracker struct {
 
 var sysTracker = &systemTracker{}
 
+type SystemActivityCursor struct {
+	Timestamp       time.Time `json:"timestamp"`
+	ContextSwitches uint64    `json:"contextSwitches"`
+	Forks           uint64    `json:"forks"`
+	Interrupts      uint64    `json:"interrupts"`
+}
+
 func (self *GopsUtil) GetSystemInfo() (*models.SystemInfo, error) {
+	return self.GetSystemInfoWithCursor("")
+}
+
+func (self *GopsUtil) GetSystemInfoWithCursor(cursorStr string) (*models.SystemInfo, error) {
 	// System info
 	loadAvg, _ := load.Avg()
 	procs, _ := process.Pids()
@@ -27,12 +42,116 @@ func (self *GopsUtil) GetSystemInfo() (*models.SystemInfo, error) {
 
 	threadCount := self.getThreadCountCached(procs)
 
-	return &models.SystemInfo{
+	info := &models.SystemInfo{
 		LoadAvg:   fmt.Sprintf("%.2f %.2f %.2f", loadAvg.Load1, loadAvg.Load5, loadAvg.Load15),
 		Processes: len(procs),
 		Threads:   threadCount,
 		BootTime:  time.Unix(int64(bootTime), 0).Format("2006-01-02 15:04:05"),
-	}, nil
+	}
+
+	// Kernel activity counters are best effort; not every platform exposes them
+	activity, err := self.readKernelActivity()
+	if err != nil {
+		return info, nil
+	}
+
+	currentTime := time.Now()
+	if cursorStr != "" {
+		cursor, err := parseSystemActivityCursor(cursorStr)
+		if err == nil {
+			applyKernelActivityRates(activity, cursor, currentTime.Sub(cursor.Timestamp).Seconds())
+		}
+	}
+
+	newCursorStr, err := encodeSystemActivityCursor(SystemActivityCursor{
+		Timestamp:       currentTime,
+		ContextSwitches: activity.ContextSwitches,
+		Forks:           activity.Forks,
+		Interrupts:      activity.Interrupts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info.Activity = activity
+	info.Cursor = newCursorStr
+	return info, nil
+}
+
+func applyKernelActivityRates(activity *models.KernelActivity, cursor SystemActivityCursor, timeDiff float64) {
+	if timeDiff <= 0 {
+		return
+	}
+	activity.ContextSwitchRate = counterRate(activity.ContextSwitches, cursor.ContextSwitches, timeDiff)
+	activity.ForkRate = counterRate(activity.Forks, cursor.Forks, timeDiff)
+	activity.InterruptRate = counterRate(activity.Interrupts, cursor.Interrupts, timeDiff)
+}
+
+func counterRate(curr, prev uint64, timeDiff float64) float64 {
+	if curr < prev {
+		return 0
+	}
+	return float64(curr-prev) / timeDiff
+}
+
+// parseKernelActivity extracts the ctxt, processes, procs_running,
+// procs_blocked and intr lines from /proc/stat.
+func parseKernelActivity(content string) (*models.KernelActivity, error) {
+	activity := &models.KernelActivity{}
+	found := false
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch fields[0] {
+		case "ctxt":
+			activity.ContextSwitches = value
+		case "processes":
+			activity.Forks = value
+		case "intr":
+			activity.Interrupts = value
+		case "procs_running":
+			activity.ProcsRunning = int(value)
+		case "procs_blocked":
+			activity.ProcsBlocked = int(value)
+		default:
+			continue
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no kernel activity counters found")
+	}
+	return activity, nil
+}
+
+func encodeSystemActivityCursor(cursor SystemActivityCursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parseSystemActivityCursor(cursorStr string) (SystemActivityCursor, error) {
+	var cursor SystemActivityCursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
 }
 
 func (self *GopsUtil) getThreadCountCached(procs []int32) int {
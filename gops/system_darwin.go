@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readKernelActivity() (*models.KernelActivity, error) {
+	return nil, fmt.Errorf("kernel activity counters are not supported on darwin")
+}
@@ -0,0 +1,15 @@
+//go:build linux
+
+package gops
+
+import "github.com/AvengeMedia/dgop/models"
+
+const procStatPath = "/proc/stat"
+
+func (self *GopsUtil) readKernelActivity() (*models.KernelActivity, error) {
+	content, err := self.fs.ReadFile(procStatPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseKernelActivity(string(content))
+}
@@ -0,0 +1,73 @@
+package gops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProcStat = `cpu  10 0 20 300 0 0 0 0 0 0
+cpu0 10 0 20 300 0 0 0 0 0 0
+intr 543444 0 9 0 0
+ctxt 1146446
+btime 1760735579
+processes 14597
+procs_running 3
+procs_blocked 2
+softirq 100 0 50 0
+`
+
+func TestParseKernelActivity(t *testing.T) {
+	activity, err := parseKernelActivity(testProcStat)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1146446), activity.ContextSwitches)
+	assert.Equal(t, uint64(14597), activity.Forks)
+	assert.Equal(t, uint64(543444), activity.Interrupts)
+	assert.Equal(t, 3, activity.ProcsRunning)
+	assert.Equal(t, 2, activity.ProcsBlocked)
+}
+
+func TestParseKernelActivityMissing(t *testing.T) {
+	_, err := parseKernelActivity("cpu  1 2 3 4\n")
+	assert.Error(t, err)
+}
+
+func TestApplyKernelActivityRates(t *testing.T) {
+	activity, err := parseKernelActivity(testProcStat)
+	require.NoError(t, err)
+
+	cursor := SystemActivityCursor{
+		ContextSwitches: 1146446 - 2000,
+		Forks:           14597 - 10,
+		Interrupts:      543444 + 1, // counter went backwards
+	}
+	applyKernelActivityRates(activity, cursor, 2.0)
+
+	assert.Equal(t, 1000.0, activity.ContextSwitchRate)
+	assert.Equal(t, 5.0, activity.ForkRate)
+	assert.Equal(t, 0.0, activity.InterruptRate)
+}
+
+func TestSystemActivityCursorRoundTrip(t *testing.T) {
+	cursor := SystemActivityCursor{
+		Timestamp:       time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		ContextSwitches: 100,
+		Forks:           200,
+		Interrupts:      300,
+	}
+
+	encoded, err := encodeSystemActivityCursor(cursor)
+	require.NoError(t, err)
+
+	decoded, err := parseSystemActivityCursor(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Timestamp.Unix(), decoded.Timestamp.Unix())
+	assert.Equal(t, cursor.ContextSwitches, decoded.ContextSwitches)
+	assert.Equal(t, cursor.Interrupts, decoded.Interrupts)
+
+	_, err = parseSystemActivityCursor("not-valid-base64!!!")
+	assert.Error(t, err)
+}
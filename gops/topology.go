@@ -0,0 +1,149 @@
+package gops
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const (
+	CoreTypePerformance = "performance"
+	CoreTypeEfficiency  = "efficiency"
+)
+
+func (self *GopsUtil) GetCPUTopology() (*models.CPUTopology, error) {
+	topology, err := self.readCPUTopology()
+	if err != nil {
+		return nil, err
+	}
+	summarizeCPUTopology(topology)
+	return topology, nil
+}
+
+// summarizeCPUTopology fills in the package/die/core/thread counts from the
+// per-thread entries and sorts everything into a stable order.
+func summarizeCPUTopology(topology *models.CPUTopology) {
+	sort.Slice(topology.CPUs, func(i, j int) bool {
+		return topology.CPUs[i].CPU < topology.CPUs[j].CPU
+	})
+	sort.Slice(topology.Caches, func(i, j int) bool {
+		a, b := topology.Caches[i], topology.Caches[j]
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return firstCPU(a.SharedCPUs) < firstCPU(b.SharedCPUs)
+	})
+	sort.Slice(topology.NUMANodes, func(i, j int) bool {
+		return topology.NUMANodes[i].Node < topology.NUMANodes[j].Node
+	})
+
+	packages := make(map[int]bool)
+	dies := make(map[[2]int]bool)
+	cores := make(map[[3]int]bool)
+	coreTypes := make(map[string]bool)
+	for _, t := range topology.CPUs {
+		packages[t.Package] = true
+		dies[[2]int{t.Package, t.Die}] = true
+		cores[[3]int{t.Package, t.Die, t.Core}] = true
+		if t.CoreType != "" {
+			coreTypes[t.CoreType] = true
+		}
+	}
+
+	topology.Packages = len(packages)
+	topology.Dies = len(dies)
+	topology.Cores = len(cores)
+	topology.Threads = len(topology.CPUs)
+	topology.Hybrid = len(coreTypes) > 1
+}
+
+// classifyCoreTypesByCapacity marks the highest-capacity CPUs as performance
+// cores and the rest as efficiency cores. Homogeneous systems are left alone.
+func classifyCoreTypesByCapacity(cpus []models.CPUThread) {
+	minCap, maxCap := -1, -1
+	for _, t := range cpus {
+		if t.Capacity <= 0 {
+			return
+		}
+		if minCap < 0 || t.Capacity < minCap {
+			minCap = t.Capacity
+		}
+		if t.Capacity > maxCap {
+			maxCap = t.Capacity
+		}
+	}
+	if minCap == maxCap {
+		return
+	}
+
+	for i := range cpus {
+		if cpus[i].Capacity == maxCap {
+			cpus[i].CoreType = CoreTypePerformance
+		} else {
+			cpus[i].CoreType = CoreTypeEfficiency
+		}
+	}
+}
+
+// parseCPUList parses the kernel cpulist format, e.g. "0-3,8,10-11".
+func parseCPUList(list string) ([]int, error) {
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return nil, nil
+	}
+
+	var cpus []int
+	for _, part := range strings.Split(list, ",") {
+		lo, hi, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpu list %q: %w", list, err)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(hi)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cpu list %q: %w", list, err)
+			}
+		}
+		if end < start {
+			return nil, fmt.Errorf("invalid cpu range %q", part)
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus, nil
+}
+
+// parseCacheSize parses sysfs cache sizes such as "48K" or "32M" into bytes.
+func parseCacheSize(size string) (uint64, error) {
+	size = strings.TrimSpace(size)
+	multiplier := uint64(1)
+	switch {
+	case strings.HasSuffix(size, "K"):
+		multiplier = 1024
+	case strings.HasSuffix(size, "M"):
+		multiplier = 1024 * 1024
+	case strings.HasSuffix(size, "G"):
+		multiplier = 1024 * 1024 * 1024
+	}
+	value, err := strconv.ParseUint(strings.TrimRight(size, "KMG"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return value * multiplier, nil
+}
+
+func firstCPU(cpus []int) int {
+	if len(cpus) == 0 {
+		return -1
+	}
+	return cpus[0]
+}
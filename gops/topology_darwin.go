@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readCPUTopology() (*models.CPUTopology, error) {
+	return nil, fmt.Errorf("cpu topology is not supported on darwin")
+}
@@ -0,0 +1,177 @@
+//go:build linux
+
+package gops
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const (
+	nodeSysfsPath    = "/sys/devices/system/node"
+	devicesSysfsPath = "/sys/devices"
+)
+
+func (self *GopsUtil) readCPUTopology() (*models.CPUTopology, error) {
+	return self.readCPUTopologyFrom(cpuSysfsPath, nodeSysfsPath, devicesSysfsPath)
+}
+
+func (self *GopsUtil) readCPUTopologyFrom(cpuRoot, nodeRoot, devicesRoot string) (*models.CPUTopology, error) {
+	entries, err := self.fs.ReadDir(cpuRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	numaByCPU, numaNodes := self.readNUMANodes(nodeRoot)
+
+	topology := &models.CPUTopology{NUMANodes: numaNodes}
+	caches := make(map[string]bool)
+
+	for _, entry := range entries {
+		idx, ok := parseCPUDirIndex(entry.Name())
+		if !ok {
+			continue
+		}
+		cpuDir := filepath.Join(cpuRoot, entry.Name())
+		topoDir := filepath.Join(cpuDir, "topology")
+		if _, err := self.fs.Stat(topoDir); err != nil {
+			// Offline CPUs have no topology directory
+			continue
+		}
+
+		thread := models.CPUThread{
+			CPU:      idx,
+			Package:  self.readSysfsInt(filepath.Join(topoDir, "physical_package_id"), 0),
+			Die:      self.readSysfsInt(filepath.Join(topoDir, "die_id"), 0),
+			Core:     self.readSysfsInt(filepath.Join(topoDir, "core_id"), idx),
+			NUMANode: numaByCPU[idx],
+			Capacity: self.readSysfsInt(filepath.Join(cpuDir, "cpu_capacity"), 0),
+		}
+
+		siblings := self.readSysfsString(filepath.Join(topoDir, "core_cpus_list"))
+		if siblings == "" {
+			siblings = self.readSysfsString(filepath.Join(topoDir, "thread_siblings_list"))
+		}
+		thread.Siblings, _ = parseCPUList(siblings)
+		if len(thread.Siblings) == 0 {
+			thread.Siblings = []int{idx}
+		}
+
+		topology.CPUs = append(topology.CPUs, thread)
+		topology.Caches = self.appendCPUCaches(topology.Caches, caches, filepath.Join(cpuDir, "cache"))
+	}
+
+	if len(topology.CPUs) == 0 {
+		return nil, fmt.Errorf("no cpu topology found in %s", cpuRoot)
+	}
+
+	if !self.applyHybridPMUCoreTypes(topology.CPUs, devicesRoot) {
+		classifyCoreTypesByCapacity(topology.CPUs)
+	}
+
+	return topology, nil
+}
+
+// readNUMANodes maps each CPU to its NUMA node. Systems without NUMA support
+// report everything on node 0.
+func (self *GopsUtil) readNUMANodes(nodeRoot string) (map[int]int, []models.CPUNUMANode) {
+	byCPU := make(map[int]int)
+	entries, err := self.fs.ReadDir(nodeRoot)
+	if err != nil {
+		return byCPU, nil
+	}
+
+	var nodes []models.CPUNUMANode
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "node") {
+			continue
+		}
+		node, err := strconv.Atoi(strings.TrimPrefix(entry.Name(), "node"))
+		if err != nil {
+			continue
+		}
+		cpus, err := parseCPUList(self.readSysfsString(filepath.Join(nodeRoot, entry.Name(), "cpulist")))
+		if err != nil {
+			continue
+		}
+		for _, cpu := range cpus {
+			byCPU[cpu] = node
+		}
+		nodes = append(nodes, models.CPUNUMANode{Node: node, CPUs: cpus})
+	}
+	return byCPU, nodes
+}
+
+// appendCPUCaches adds each cache instance once, keyed by level, type and the
+// set of CPUs sharing it.
+func (self *GopsUtil) appendCPUCaches(caches []models.CPUCache, seen map[string]bool, cacheDir string) []models.CPUCache {
+	entries, err := self.fs.ReadDir(cacheDir)
+	if err != nil {
+		return caches
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "index") {
+			continue
+		}
+		dir := filepath.Join(cacheDir, entry.Name())
+
+		level := self.readSysfsInt(filepath.Join(dir, "level"), 0)
+		cacheType := self.readSysfsString(filepath.Join(dir, "type"))
+		shared := self.readSysfsString(filepath.Join(dir, "shared_cpu_list"))
+		if level == 0 || shared == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%d/%s/%s", level, cacheType, shared)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		size, _ := parseCacheSize(self.readSysfsString(filepath.Join(dir, "size")))
+		sharedCPUs, _ := parseCPUList(shared)
+		caches = append(caches, models.CPUCache{
+			Level:      level,
+			Type:       cacheType,
+			Size:       size,
+			LineSize:   self.readSysfsInt(filepath.Join(dir, "coherency_line_size"), 0),
+			SharedCPUs: sharedCPUs,
+		})
+	}
+	return caches
+}
+
+// applyHybridPMUCoreTypes uses the Intel hybrid PMU devices (cpu_core and
+// cpu_atom) to classify P-cores and E-cores. Returns false when absent.
+func (self *GopsUtil) applyHybridPMUCoreTypes(cpus []models.CPUThread, devicesRoot string) bool {
+	pCores, err := parseCPUList(self.readSysfsString(filepath.Join(devicesRoot, "cpu_core", "cpus")))
+	if err != nil || len(pCores) == 0 {
+		return false
+	}
+	eCores, _ := parseCPUList(self.readSysfsString(filepath.Join(devicesRoot, "cpu_atom", "cpus")))
+
+	types := make(map[int]string)
+	for _, cpu := range pCores {
+		types[cpu] = CoreTypePerformance
+	}
+	for _, cpu := range eCores {
+		types[cpu] = CoreTypeEfficiency
+	}
+	for i := range cpus {
+		cpus[i].CoreType = types[cpus[i].CPU]
+	}
+	return true
+}
+
+func (self *GopsUtil) readSysfsInt(path string, fallback int) int {
+	value, err := strconv.Atoi(self.readSysfsString(path))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
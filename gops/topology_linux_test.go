@@ -0,0 +1,70 @@
+//go:build linux
+
+package gops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCPUTopologyFrom(t *testing.T) {
+	files := map[string]string{
+		"/sys/devices/system/node/node0/cpulist": "0-1",
+		"/sys/devices/system/node/node1/cpulist": "2",
+	}
+	for cpu, topo := range map[string][3]string{
+		"cpu0": {"0", "0", "0-1"},
+		"cpu1": {"0", "0", "0-1"},
+		"cpu2": {"1", "4", "2"},
+	} {
+		files["/sys/devices/system/cpu/"+cpu+"/topology/physical_package_id"] = topo[0]
+		files["/sys/devices/system/cpu/"+cpu+"/topology/core_id"] = topo[1]
+		files["/sys/devices/system/cpu/"+cpu+"/topology/die_id"] = "0"
+		files["/sys/devices/system/cpu/"+cpu+"/topology/thread_siblings_list"] = topo[2]
+		files["/sys/devices/system/cpu/"+cpu+"/cache/index0/level"] = "1"
+		files["/sys/devices/system/cpu/"+cpu+"/cache/index0/type"] = "Data"
+		files["/sys/devices/system/cpu/"+cpu+"/cache/index0/size"] = "48K"
+		files["/sys/devices/system/cpu/"+cpu+"/cache/index0/coherency_line_size"] = "64"
+		files["/sys/devices/system/cpu/"+cpu+"/cache/index0/shared_cpu_list"] = topo[2]
+	}
+	// Offline CPU without a topology directory
+	files["/sys/devices/system/cpu/cpu3/online"] = "0"
+	files["/sys/devices/system/cpu/cpufreq/boost"] = "1"
+	files["/sys/devices/cpu_core/cpus"] = "0-1"
+	files["/sys/devices/cpu_atom/cpus"] = "2"
+	gops, _ := newFixtureGops(files)
+
+	topology, err := gops.readCPUTopology()
+	require.NoError(t, err)
+	summarizeCPUTopology(topology)
+
+	assert.Equal(t, 2, topology.Packages)
+	assert.Equal(t, 2, topology.Cores)
+	assert.Equal(t, 3, topology.Threads)
+	assert.True(t, topology.Hybrid)
+
+	require.Len(t, topology.CPUs, 3)
+	assert.Equal(t, []int{0, 1}, topology.CPUs[1].Siblings)
+	assert.Equal(t, CoreTypePerformance, topology.CPUs[1].CoreType)
+	assert.Equal(t, 1, topology.CPUs[2].Package)
+	assert.Equal(t, 4, topology.CPUs[2].Core)
+	assert.Equal(t, 1, topology.CPUs[2].NUMANode)
+	assert.Equal(t, CoreTypeEfficiency, topology.CPUs[2].CoreType)
+
+	require.Len(t, topology.Caches, 2)
+	assert.Equal(t, uint64(48*1024), topology.Caches[0].Size)
+	assert.Equal(t, 64, topology.Caches[0].LineSize)
+	assert.Equal(t, []int{0, 1}, topology.Caches[0].SharedCPUs)
+	assert.Equal(t, []int{2}, topology.Caches[1].SharedCPUs)
+
+	require.Len(t, topology.NUMANodes, 2)
+	assert.Equal(t, []int{2}, topology.NUMANodes[1].CPUs)
+}
+
+func TestReadCPUTopologyFromMissingRoot(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	_, err := gops.readCPUTopology()
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPUList(t *testing.T) {
+	cpus, err := parseCPUList("0-3,8,10-11\n")
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 8, 10, 11}, cpus)
+
+	cpus, err = parseCPUList("")
+	require.NoError(t, err)
+	assert.Empty(t, cpus)
+
+	_, err = parseCPUList("3-1")
+	assert.Error(t, err)
+
+	_, err = parseCPUList("a-b")
+	assert.Error(t, err)
+}
+
+func TestParseCacheSize(t *testing.T) {
+	tests := map[string]uint64{
+		"48K":   48 * 1024,
+		"2048K": 2 * 1024 * 1024,
+		"32M":   32 * 1024 * 1024,
+		"512":   512,
+	}
+	for input, expected := range tests {
+		size, err := parseCacheSize(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, size, input)
+	}
+
+	_, err := parseCacheSize("big")
+	assert.Error(t, err)
+}
+
+func TestClassifyCoreTypesByCapacity(t *testing.T) {
+	cpus := []models.CPUThread{
+		{CPU: 0, Capacity: 446},
+		{CPU: 1, Capacity: 446},
+		{CPU: 2, Capacity: 1024},
+		{CPU: 3, Capacity: 1024},
+	}
+	classifyCoreTypesByCapacity(cpus)
+	assert.Equal(t, CoreTypeEfficiency, cpus[0].CoreType)
+	assert.Equal(t, CoreTypeEfficiency, cpus[1].CoreType)
+	assert.Equal(t, CoreTypePerformance, cpus[2].CoreType)
+	assert.Equal(t, CoreTypePerformance, cpus[3].CoreType)
+
+	uniform := []models.CPUThread{{CPU: 0, Capacity: 1024}, {CPU: 1, Capacity: 1024}}
+	classifyCoreTypesByCapacity(uniform)
+	assert.Empty(t, uniform[0].CoreType)
+	assert.Empty(t, uniform[1].CoreType)
+}
+
+func TestSummarizeCPUTopology(t *testing.T) {
+	topology := &models.CPUTopology{
+		CPUs: []models.CPUThread{
+			{CPU: 2, Package: 0, Core: 0, CoreType: CoreTypePerformance},
+			{CPU: 0, Package: 0, Core: 0, CoreType: CoreTypePerformance},
+			{CPU: 1, Package: 0, Core: 1, CoreType: CoreTypePerformance},
+			{CPU: 3, Package: 0, Core: 8, CoreType: CoreTypeEfficiency},
+		},
+	}
+	summarizeCPUTopology(topology)
+
+	assert.Equal(t, 1, topology.Packages)
+	assert.Equal(t, 1, topology.Dies)
+	assert.Equal(t, 3, topology.Cores)
+	assert.Equal(t, 4, topology.Threads)
+	assert.True(t, topology.Hybrid)
+	assert.Equal(t, 0, topology.CPUs[0].CPU)
+	assert.Equal(t, 3, topology.CPUs[3].CPU)
+}
@@ -0,0 +1,169 @@
+package gops
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+type VMStatCursor struct {
+	Timestamp time.Time             `json:"timestamp"`
+	Counters  models.VMStatCounters `json:"counters"`
+}
+
+func (self *GopsUtil) GetVMStat(cursorStr string) (*models.VMStatResponse, error) {
+	counters, err := self.readVMStatCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := time.Now()
+	response := &models.VMStatResponse{Counters: *counters}
+
+	// If we have a cursor, calculate rates
+	if cursorStr != "" {
+		cursor, err := parseVMStatCursor(cursorStr)
+		if err == nil {
+			timeDiff := currentTime.Sub(cursor.Timestamp).Seconds()
+			if timeDiff > 0 {
+				applyVMStatRates(response, cursor.Counters, timeDiff)
+			}
+		}
+	}
+
+	newCursorStr, err := encodeVMStatCursor(VMStatCursor{
+		Timestamp: currentTime,
+		Counters:  *counters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response.Cursor = newCursorStr
+
+	return response, nil
+}
+
+func applyVMStatRates(response *models.VMStatResponse, prev models.VMStatCounters, timeDiff float64) {
+	curr := response.Counters
+	response.Rates = models.VMStatRates{
+		PgFault:          counterRate(curr.PgFault, prev.PgFault, timeDiff),
+		PgMajFault:       counterRate(curr.PgMajFault, prev.PgMajFault, timeDiff),
+		PgpgIn:           counterRate(curr.PgpgIn, prev.PgpgIn, timeDiff),
+		PgpgOut:          counterRate(curr.PgpgOut, prev.PgpgOut, timeDiff),
+		PswpIn:           counterRate(curr.PswpIn, prev.PswpIn, timeDiff),
+		PswpOut:          counterRate(curr.PswpOut, prev.PswpOut, timeDiff),
+		PgScanDirect:     counterRate(curr.PgScanDirect, prev.PgScanDirect, timeDiff),
+		PgScanKswapd:     counterRate(curr.PgScanKswapd, prev.PgScanKswapd, timeDiff),
+		PgStealDirect:    counterRate(curr.PgStealDirect, prev.PgStealDirect, timeDiff),
+		PgStealKswapd:    counterRate(curr.PgStealKswapd, prev.PgStealKswapd, timeDiff),
+		AllocStall:       counterRate(curr.AllocStall, prev.AllocStall, timeDiff),
+		ThpFaultAlloc:    counterRate(curr.ThpFaultAlloc, prev.ThpFaultAlloc, timeDiff),
+		ThpFaultFallback: counterRate(curr.ThpFaultFallback, prev.ThpFaultFallback, timeDiff),
+		ThpCollapseAlloc: counterRate(curr.ThpCollapseAlloc, prev.ThpCollapseAlloc, timeDiff),
+		ThpCollapseFail:  counterRate(curr.ThpCollapseFail, prev.ThpCollapseFail, timeDiff),
+		OomKill:          counterRate(curr.OomKill, prev.OomKill, timeDiff),
+	}
+
+	if curr.OomKill >= prev.OomKill {
+		response.OomKills = curr.OomKill - prev.OomKill
+	}
+
+	// Share of scanned pages that were actually reclaimed in the interval
+	scanned := response.Rates.PgScanDirect + response.Rates.PgScanKswapd
+	if scanned > 0 {
+		stolen := response.Rates.PgStealDirect + response.Rates.PgStealKswapd
+		response.ReclaimEfficiency = stolen / scanned * 100
+		if response.ReclaimEfficiency > 100 {
+			response.ReclaimEfficiency = 100
+		}
+	}
+}
+
+// parseVMStat extracts the paging and reclaim counters from /proc/vmstat.
+// allocstall is split per zone since 4.10 and is summed back together here.
+func parseVMStat(content string) (*models.VMStatCounters, error) {
+	counters := &models.VMStatCounters{}
+	found := false
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		found = true
+
+		key := fields[0]
+		switch key {
+		case "pgfault":
+			counters.PgFault = value
+		case "pgmajfault":
+			counters.PgMajFault = value
+		case "pgpgin":
+			counters.PgpgIn = value
+		case "pgpgout":
+			counters.PgpgOut = value
+		case "pswpin":
+			counters.PswpIn = value
+		case "pswpout":
+			counters.PswpOut = value
+		case "pgscan_direct":
+			counters.PgScanDirect = value
+		case "pgscan_kswapd":
+			counters.PgScanKswapd = value
+		case "pgsteal_direct":
+			counters.PgStealDirect = value
+		case "pgsteal_kswapd":
+			counters.PgStealKswapd = value
+		case "thp_fault_alloc":
+			counters.ThpFaultAlloc = value
+		case "thp_fault_fallback":
+			counters.ThpFaultFallback = value
+		case "thp_collapse_alloc":
+			counters.ThpCollapseAlloc = value
+		case "thp_collapse_alloc_failed":
+			counters.ThpCollapseFail = value
+		case "oom_kill":
+			counters.OomKill = value
+		case "allocstall":
+			counters.AllocStall += value
+		default:
+			if strings.HasPrefix(key, "allocstall_") {
+				counters.AllocStall += value
+			}
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no vmstat counters found")
+	}
+	return counters, nil
+}
+
+func encodeVMStatCursor(cursor VMStatCursor) (string, error) {
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+func parseVMStatCursor(cursorStr string) (VMStatCursor, error) {
+	var cursor VMStatCursor
+
+	jsonData, err := base64.StdEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(jsonData, &cursor)
+	return cursor, err
+}
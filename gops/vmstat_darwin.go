@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readVMStatCounters() (*models.VMStatCounters, error) {
+	return nil, fmt.Errorf("vmstat is not supported on darwin")
+}
@@ -0,0 +1,15 @@
+//go:build linux
+
+package gops
+
+import "github.com/AvengeMedia/dgop/models"
+
+const vmstatPath = "/proc/vmstat"
+
+func (self *GopsUtil) readVMStatCounters() (*models.VMStatCounters, error) {
+	data, err := self.fs.ReadFile(vmstatPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseVMStat(string(data))
+}
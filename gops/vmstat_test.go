@@ -0,0 +1,86 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleVMStat = `nr_free_pages 812345
+pgpgin 731442
+pgpgout 1226472
+pswpin 10
+pswpout 20
+pgfault 16627601
+pgmajfault 465
+pgsteal_kswapd 900
+pgsteal_direct 100
+pgscan_kswapd 1000
+pgscan_direct 400
+pgscan_direct_throttle 0
+allocstall_dma 0
+allocstall_dma32 1
+allocstall_normal 4
+allocstall_movable 2
+oom_kill 3
+thp_fault_alloc 50
+thp_fault_fallback 5
+thp_collapse_alloc 7
+thp_collapse_alloc_failed 1
+`
+
+func TestParseVMStat(t *testing.T) {
+	c, err := parseVMStat(sampleVMStat)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(16627601), c.PgFault)
+	assert.Equal(t, uint64(465), c.PgMajFault)
+	assert.Equal(t, uint64(731442), c.PgpgIn)
+	assert.Equal(t, uint64(1226472), c.PgpgOut)
+	assert.Equal(t, uint64(10), c.PswpIn)
+	assert.Equal(t, uint64(20), c.PswpOut)
+	assert.Equal(t, uint64(400), c.PgScanDirect)
+	assert.Equal(t, uint64(1000), c.PgScanKswapd)
+	assert.Equal(t, uint64(100), c.PgStealDirect)
+	assert.Equal(t, uint64(900), c.PgStealKswapd)
+	assert.Equal(t, uint64(7), c.AllocStall)
+	assert.Equal(t, uint64(50), c.ThpFaultAlloc)
+	assert.Equal(t, uint64(5), c.ThpFaultFallback)
+	assert.Equal(t, uint64(7), c.ThpCollapseAlloc)
+	assert.Equal(t, uint64(1), c.ThpCollapseFail)
+	assert.Equal(t, uint64(3), c.OomKill)
+}
+
+func TestParseVMStatEmpty(t *testing.T) {
+	_, err := parseVMStat("")
+	assert.Error(t, err)
+}
+
+func TestApplyVMStatRates(t *testing.T) {
+	prev := models.VMStatCounters{PgFault: 1000, PswpIn: 10, PgScanKswapd: 100, PgStealKswapd: 50, OomKill: 1}
+	resp := &models.VMStatResponse{Counters: models.VMStatCounters{
+		PgFault: 3000, PswpIn: 30, PgScanKswapd: 300, PgStealKswapd: 200, OomKill: 2,
+	}}
+
+	applyVMStatRates(resp, prev, 2)
+
+	assert.Equal(t, 1000.0, resp.Rates.PgFault)
+	assert.Equal(t, 10.0, resp.Rates.PswpIn)
+	assert.Equal(t, 100.0, resp.Rates.PgScanKswapd)
+	assert.Equal(t, 0.5, resp.Rates.OomKill)
+	assert.Equal(t, uint64(1), resp.OomKills)
+	assert.InDelta(t, 75.0, resp.ReclaimEfficiency, 0.001)
+}
+
+func TestApplyVMStatRatesCounterReset(t *testing.T) {
+	prev := models.VMStatCounters{PgFault: 5000, OomKill: 4}
+	resp := &models.VMStatResponse{Counters: models.VMStatCounters{PgFault: 100, OomKill: 0}}
+
+	applyVMStatRates(resp, prev, 1)
+
+	assert.Equal(t, 0.0, resp.Rates.PgFault)
+	assert.Equal(t, uint64(0), resp.OomKills)
+	assert.Equal(t, 0.0, resp.ReclaimEfficiency)
+}
@@ -0,0 +1,75 @@
+package gops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) GetZram() (*models.ZramInfo, error) {
+	return self.readZramInfo()
+}
+
+// parseZramMMStat fills the byte counters from a zram mm_stat line:
+// orig_data_size compr_data_size mem_used_total mem_limit mem_used_max
+// same_pages pages_compacted huge_pages [huge_pages_since]
+func parseZramMMStat(dev *models.ZramDevice, content string) error {
+	fields := strings.Fields(content)
+	if len(fields) < 5 {
+		return fmt.Errorf("unexpected mm_stat format: %q", content)
+	}
+
+	values := make([]uint64, len(fields))
+	for i, field := range fields {
+		v, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid mm_stat value %q: %w", field, err)
+		}
+		values[i] = v
+	}
+
+	dev.OrigData = values[0]
+	dev.ComprData = values[1]
+	dev.MemUsed = values[2]
+	dev.MemLimit = values[3]
+	dev.MemUsedMax = values[4]
+	if len(values) > 5 {
+		dev.SamePages = values[5]
+	}
+	if len(values) > 7 {
+		dev.HugePages = values[7]
+	}
+	dev.CompressionRatio = compressionRatio(dev.OrigData, dev.MemUsed)
+	return nil
+}
+
+// parseZramAlgorithm returns the active algorithm from comp_algorithm,
+// which lists every available one with the selected one in brackets.
+func parseZramAlgorithm(content string) string {
+	for _, field := range strings.Fields(content) {
+		if strings.HasPrefix(field, "[") && strings.HasSuffix(field, "]") {
+			return strings.Trim(field, "[]")
+		}
+	}
+	return strings.TrimSpace(content)
+}
+
+// compressionRatio is original bytes per byte of RAM actually spent on the pool
+func compressionRatio(orig, used uint64) float64 {
+	if used == 0 {
+		return 0
+	}
+	return float64(orig) / float64(used)
+}
+
+// trailingNumber extracts the trailing number from device names like "zram12"
+// or "nvme10" so that they sort numerically.
+func trailingNumber(name string) int {
+	idx, err := strconv.Atoi(name[len(strings.TrimRight(name, "0123456789")):])
+	if err != nil {
+		return -1
+	}
+	return idx
+}
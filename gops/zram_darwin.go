@@ -0,0 +1,13 @@
+//go:build darwin
+
+package gops
+
+import (
+	"fmt"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+func (self *GopsUtil) readZramInfo() (*models.ZramInfo, error) {
+	return nil, fmt.Errorf("zram and zswap are not supported on darwin")
+}
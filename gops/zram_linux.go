@@ -0,0 +1,104 @@
+//go:build linux
+
+package gops
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AvengeMedia/dgop/models"
+)
+
+const (
+	blockSysfsPath   = "/sys/block"
+	zswapParamsPath  = "/sys/module/zswap/parameters"
+	zswapDebugfsPath = "/sys/kernel/debug/zswap"
+)
+
+func (self *GopsUtil) readZramInfo() (*models.ZramInfo, error) {
+	return self.readZramInfoFrom(blockSysfsPath, zswapParamsPath, zswapDebugfsPath, meminfoPath)
+}
+
+func (self *GopsUtil) readZramInfoFrom(blockRoot, zswapParams, zswapDebug, meminfo string) (*models.ZramInfo, error) {
+	info := &models.ZramInfo{
+		Devices: self.readZramDevices(blockRoot),
+		Zswap:   self.readZswapInfo(zswapParams, zswapDebug, meminfo),
+	}
+
+	if len(info.Devices) == 0 && info.Zswap == nil {
+		return nil, fmt.Errorf("no zram devices or zswap found")
+	}
+	return info, nil
+}
+
+func (self *GopsUtil) readZramDevices(blockRoot string) []*models.ZramDevice {
+	entries, err := self.fs.ReadDir(blockRoot)
+	if err != nil {
+		return nil
+	}
+
+	var devices []*models.ZramDevice
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "zram") {
+			continue
+		}
+		dir := filepath.Join(blockRoot, entry.Name())
+
+		// Devices that were never configured have a zero disksize
+		diskSize, err := self.readSysfsUint(filepath.Join(dir, "disksize"))
+		if err != nil || diskSize == 0 {
+			continue
+		}
+
+		dev := &models.ZramDevice{
+			Device:    entry.Name(),
+			Algorithm: parseZramAlgorithm(self.readSysfsString(filepath.Join(dir, "comp_algorithm"))),
+			DiskSize:  diskSize,
+		}
+		if err := parseZramMMStat(dev, self.readSysfsString(filepath.Join(dir, "mm_stat"))); err != nil {
+			continue
+		}
+
+		devices = append(devices, dev)
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		return trailingNumber(devices[i].Device) < trailingNumber(devices[j].Device)
+	})
+	return devices
+}
+
+// readZswapInfo combines the module parameters with the pool statistics. The
+// debugfs counters need root, so the Zswap/Zswapped meminfo fields (5.19+) are
+// used for pool and original sizes when debugfs is unreadable.
+func (self *GopsUtil) readZswapInfo(paramsDir, debugDir, meminfo string) *models.ZswapInfo {
+	enabled := self.readSysfsString(filepath.Join(paramsDir, "enabled"))
+	if enabled == "" {
+		return nil
+	}
+
+	zswap := &models.ZswapInfo{
+		Enabled:    enabled == "Y" || enabled == "1",
+		Compressor: self.readSysfsString(filepath.Join(paramsDir, "compressor")),
+		Zpool:      self.readSysfsString(filepath.Join(paramsDir, "zpool")),
+	}
+	zswap.MaxPoolPercent, _ = strconv.Atoi(self.readSysfsString(filepath.Join(paramsDir, "max_pool_percent")))
+
+	if poolSize, err := self.readSysfsUint(filepath.Join(debugDir, "pool_total_size")); err == nil {
+		zswap.PoolSize = poolSize
+		zswap.StoredPages, _ = self.readSysfsUint(filepath.Join(debugDir, "stored_pages"))
+		zswap.WrittenBackPages, _ = self.readSysfsUint(filepath.Join(debugDir, "written_back_pages"))
+		zswap.PoolLimitHit, _ = self.readSysfsUint(filepath.Join(debugDir, "pool_limit_hit"))
+		zswap.OrigData = zswap.StoredPages * uint64(os.Getpagesize())
+	} else if fields, err := self.readMeminfoFields(meminfo); err == nil {
+		zswap.PoolSize = fields["Zswap"] * 1024
+		zswap.OrigData = fields["Zswapped"] * 1024
+	}
+
+	zswap.CompressionRatio = compressionRatio(zswap.OrigData, zswap.PoolSize)
+	return zswap
+}
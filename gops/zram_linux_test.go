@@ -0,0 +1,66 @@
+//go:build linux
+
+package gops
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadZramInfoFrom(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/block/zram0/disksize":                     "8589934592",
+		"/sys/block/zram0/comp_algorithm":               "lzo [zstd]",
+		"/sys/block/zram0/mm_stat":                      "4000 1000 2000 0 2500 0 0 0 0",
+		"/sys/block/zram1/disksize":                     "0",
+		"/sys/block/vda/size":                           "100",
+		"/sys/module/zswap/parameters/enabled":          "Y",
+		"/sys/module/zswap/parameters/compressor":       "zstd",
+		"/sys/module/zswap/parameters/zpool":            "zsmalloc",
+		"/sys/module/zswap/parameters/max_pool_percent": "20",
+		"/proc/meminfo":                                 "Zswap:  1024 kB\nZswapped:  3072 kB",
+	})
+
+	info, err := gops.readZramInfo()
+	require.NoError(t, err)
+
+	require.Len(t, info.Devices, 1)
+	dev := info.Devices[0]
+	assert.Equal(t, "zram0", dev.Device)
+	assert.Equal(t, "zstd", dev.Algorithm)
+	assert.Equal(t, uint64(8589934592), dev.DiskSize)
+	assert.Equal(t, 2.0, dev.CompressionRatio)
+
+	require.NotNil(t, info.Zswap)
+	assert.True(t, info.Zswap.Enabled)
+	assert.Equal(t, "zsmalloc", info.Zswap.Zpool)
+	assert.Equal(t, 20, info.Zswap.MaxPoolPercent)
+	assert.Equal(t, uint64(1024*1024), info.Zswap.PoolSize)
+	assert.Equal(t, uint64(3072*1024), info.Zswap.OrigData)
+	assert.Equal(t, 3.0, info.Zswap.CompressionRatio)
+}
+
+func TestReadZramInfoFromDebugfs(t *testing.T) {
+	gops, _ := newFixtureGops(map[string]string{
+		"/sys/module/zswap/parameters/enabled":       "Y",
+		"/sys/kernel/debug/zswap/pool_total_size":    "4096",
+		"/sys/kernel/debug/zswap/stored_pages":       "4",
+		"/sys/kernel/debug/zswap/written_back_pages": "7",
+	})
+
+	info, err := gops.readZramInfo()
+	require.NoError(t, err)
+	assert.Empty(t, info.Devices)
+	assert.Equal(t, uint64(4096), info.Zswap.PoolSize)
+	assert.Equal(t, uint64(4*os.Getpagesize()), info.Zswap.OrigData)
+	assert.Equal(t, uint64(7), info.Zswap.WrittenBackPages)
+}
+
+func TestReadZramInfoFromNothing(t *testing.T) {
+	gops, _ := newFixtureGops(nil)
+	_, err := gops.readZramInfo()
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package gops
+
+import (
+	"testing"
+
+	"github.com/AvengeMedia/dgop/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseZramMMStat(t *testing.T) {
+	dev := &models.ZramDevice{}
+	err := parseZramMMStat(dev, "  4194304  1048576  1310720        0  2097152      100        0       12        3\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(4194304), dev.OrigData)
+	assert.Equal(t, uint64(1048576), dev.ComprData)
+	assert.Equal(t, uint64(1310720), dev.MemUsed)
+	assert.Equal(t, uint64(2097152), dev.MemUsedMax)
+	assert.Equal(t, uint64(100), dev.SamePages)
+	assert.Equal(t, uint64(12), dev.HugePages)
+	assert.InDelta(t, 3.2, dev.CompressionRatio, 0.001)
+}
+
+func TestParseZramMMStatInvalid(t *testing.T) {
+	assert.Error(t, parseZramMMStat(&models.ZramDevice{}, "1 2 3"))
+	assert.Error(t, parseZramMMStat(&models.ZramDevice{}, "1 2 x 4 5"))
+}
+
+func TestParseZramAlgorithm(t *testing.T) {
+	assert.Equal(t, "zstd", parseZramAlgorithm("lzo lzo-rle lz4 [zstd]\n"))
+	assert.Equal(t, "lz4", parseZramAlgorithm("lz4"))
+}
+
+func TestCompressionRatio(t *testing.T) {
+	assert.Equal(t, 0.0, compressionRatio(100, 0))
+	assert.Equal(t, 4.0, compressionRatio(400, 100))
+}
+
+func TestTrailingNumber(t *testing.T) {
+	assert.Equal(t, 12, trailingNumber("zram12"))
+	assert.Equal(t, 0, trailingNumber("sda0"))
+	assert.Equal(t, -1, trailingNumber("zram"))
+}
@@ -0,0 +1,28 @@
+package models
+
+type BlockDevice struct {
+	Name               string         `json:"name"`
+	Path               string         `json:"path"`
+	MajMin             string         `json:"majMin"`
+	Type               string         `json:"type"`
+	Size               uint64         `json:"size"`
+	Model              string         `json:"model,omitempty"`
+	Vendor             string         `json:"vendor,omitempty"`
+	Serial             string         `json:"serial,omitempty"`
+	WWN                string         `json:"wwn,omitempty"`
+	Transport          string         `json:"transport,omitempty"`
+	Rotational         bool           `json:"rotational"`
+	Removable          bool           `json:"removable"`
+	ReadOnly           bool           `json:"readOnly"`
+	LogicalSectorSize  uint64         `json:"logicalSectorSize,omitempty"`
+	PhysicalSectorSize uint64         `json:"physicalSectorSize,omitempty"`
+	Scheduler          string         `json:"scheduler,omitempty"`
+	Partition          int            `json:"partition,omitempty"`
+	Parent             string         `json:"parent,omitempty"`
+	DMName             string         `json:"dmName,omitempty"`
+	RaidLevel          string         `json:"raidLevel,omitempty"`
+	Holders            []string       `json:"holders,omitempty"`
+	Slaves             []string       `json:"slaves,omitempty"`
+	Mountpoints        []string       `json:"mountpoints,omitempty"`
+	Children           []*BlockDevice `json:"children,omitempty"`
+}
@@ -0,0 +1,28 @@
+package models
+
+type Connection struct {
+	Protocol   string  `json:"protocol"`
+	LocalAddr  string  `json:"localAddr"`
+	LocalPort  int     `json:"localPort"`
+	RemoteAddr string  `json:"remoteAddr"`
+	RemotePort int     `json:"remotePort"`
+	State      string  `json:"state"`
+	TxQueue    uint64  `json:"txQueue"`
+	RxQueue    uint64  `json:"rxQueue"`
+	UID        *uint32 `json:"uid,omitempty"`
+	Inode      uint64  `json:"inode"`
+	PID        int32   `json:"pid,omitempty"`
+	Command    string  `json:"command,omitempty"`
+	Path       string  `json:"path,omitempty"`
+}
+
+type ConnectionSummary struct {
+	Total     int            `json:"total"`
+	Protocols map[string]int `json:"protocols"`
+	TCPStates map[string]int `json:"tcpStates"`
+}
+
+type ConnectionsResponse struct {
+	Connections []*Connection      `json:"connections"`
+	Summary     *ConnectionSummary `json:"summary"`
+}
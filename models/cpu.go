@@ -1,19 +1,62 @@
 package models
 
 type CPUInfo struct {
-	Count       int         `json:"count"`
-	Model       string      `json:"model"`
-	Frequency   float64     `json:"frequency"`
-	Temperature float64     `json:"temperature"`
-	Usage       float64     `json:"usage"`
-	CoreUsage   []float64   `json:"coreUsage"`
-	Total       []float64   `json:"total"`
-	Cores       [][]float64 `json:"cores"`
-	Cursor      string      `json:"cursor,omitempty"`
+	Count           int                `json:"count"`
+	Model           string             `json:"model"`
+	Frequency       float64            `json:"frequency"`
+	Temperature     float64            `json:"temperature"`
+	Usage           float64            `json:"usage"`
+	CoreUsage       []float64          `json:"coreUsage"`
+	Total           []float64          `json:"total"`
+	Cores           [][]float64        `json:"cores"`
+	Breakdown       *CPUTimeBreakdown  `json:"breakdown,omitempty"`
+	CoreBreakdown   []CPUTimeBreakdown `json:"coreBreakdown,omitempty"`
+	FreqDriver      string             `json:"freqDriver,omitempty"`
+	CoreFreqs       []CPUCoreFreq      `json:"coreFreqs,omitempty"`
+	Throttled       bool               `json:"throttled,omitempty"`
+	CoreThrottle    []CPUThrottle      `json:"coreThrottle,omitempty"`
+	PackageThrottle []CPUThrottle      `json:"packageThrottle,omitempty"`
+	Scope           string             `json:"scope,omitempty"`
+	Cgroup          string             `json:"cgroup,omitempty"`
+	CPULimit        float64            `json:"cpuLimit,omitempty"`
+	Cursor          string             `json:"cursor,omitempty"`
+}
+
+type CPUCoreFreq struct {
+	Core     int     `json:"core"`
+	Current  float64 `json:"current"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	HWMin    float64 `json:"hwMin"`
+	HWMax    float64 `json:"hwMax"`
+	Governor string  `json:"governor,omitempty"`
+	EPP      string  `json:"epp,omitempty"`
+}
+
+type CPUThrottle struct {
+	ID             int    `json:"id"`
+	Count          uint64 `json:"count"`
+	TimeMs         uint64 `json:"timeMs"`
+	IntervalCount  uint64 `json:"intervalCount,omitempty"`
+	IntervalTimeMs uint64 `json:"intervalTimeMs,omitempty"`
+}
+
+type CPUTimeBreakdown struct {
+	User    float64 `json:"user"`
+	Nice    float64 `json:"nice"`
+	System  float64 `json:"system"`
+	Idle    float64 `json:"idle"`
+	Iowait  float64 `json:"iowait"`
+	Irq     float64 `json:"irq"`
+	Softirq float64 `json:"softirq"`
+	Steal   float64 `json:"steal"`
 }
 
 type CPUCursorData struct {
-	Total     []float64   `json:"total"`
-	Cores     [][]float64 `json:"cores"`
-	Timestamp int64       `json:"timestamp"`
+	Total           []float64     `json:"total"`
+	Cores           [][]float64   `json:"cores"`
+	CoreThrottle    []CPUThrottle `json:"coreThrottle,omitempty"`
+	PackageThrottle []CPUThrottle `json:"packageThrottle,omitempty"`
+	CgroupUsageUsec uint64        `json:"cgroupUsageUsec,omitempty"`
+	Timestamp       int64         `json:"timestamp"`
 }
@@ -7,23 +7,57 @@ type DiskInfo struct {
 }
 
 type DiskMountInfo struct {
-	Device  string `json:"device"`
-	Mount   string `json:"mount"`
-	FSType  string `json:"fstype"`
-	Size    string `json:"size"`
-	Used    string `json:"used"`
-	Avail   string `json:"avail"`
-	Percent string `json:"percent"`
+	Device            string   `json:"device"`
+	Mount             string   `json:"mount"`
+	FSType            string   `json:"fstype"`
+	Size              string   `json:"size"`
+	Used              string   `json:"used"`
+	Avail             string   `json:"avail"`
+	Percent           string   `json:"percent"`
+	Disks             []string `json:"disks,omitempty"`
+	TotalBytes        uint64   `json:"totalBytes"`
+	UsedBytes         uint64   `json:"usedBytes"`
+	AvailBytes        uint64   `json:"availBytes"`
+	UsedPercent       float64  `json:"usedPercent"`
+	InodesTotal       uint64   `json:"inodesTotal"`
+	InodesUsed        uint64   `json:"inodesUsed"`
+	InodesFree        uint64   `json:"inodesFree"`
+	InodesUsedPercent float64  `json:"inodesUsedPercent"`
+	GrowthRate        float64  `json:"growthRate"`
+	FullIn            float64  `json:"fullIn,omitempty"`
+	InodeGrowthRate   float64  `json:"inodeGrowthRate"`
+	InodesFullIn      float64  `json:"inodesFullIn,omitempty"`
+}
+
+type DiskMountsResponse struct {
+	Mounts []*DiskMountInfo `json:"mounts"`
+	Cursor string           `json:"cursor"`
 }
 
 type DiskRateInfo struct {
-	Device     string  `json:"device"`
-	ReadRate   float64 `json:"readrate"`
-	WriteRate  float64 `json:"writerate"`
-	ReadTotal  uint64  `json:"readtotal"`
-	WriteTotal uint64  `json:"writetotal"`
-	ReadCount  uint64  `json:"readcount"`
-	WriteCount uint64  `json:"writecount"`
+	Device       string  `json:"device"`
+	ReadRate     float64 `json:"readrate"`
+	WriteRate    float64 `json:"writerate"`
+	ReadTotal    uint64  `json:"readtotal"`
+	WriteTotal   uint64  `json:"writetotal"`
+	ReadCount    uint64  `json:"readcount"`
+	WriteCount   uint64  `json:"writecount"`
+	ReadIOPS     float64 `json:"readiops"`
+	WriteIOPS    float64 `json:"writeiops"`
+	ReadMerged   float64 `json:"readmerged"`
+	WriteMerged  float64 `json:"writemerged"`
+	ReadAwait    float64 `json:"readawait"`
+	WriteAwait   float64 `json:"writeawait"`
+	ReadReqSize  float64 `json:"readreqsize"`
+	WriteReqSize float64 `json:"writereqsize"`
+	DiscardIOPS  float64 `json:"discardiops"`
+	DiscardRate  float64 `json:"discardrate"`
+	DiscardAwait float64 `json:"discardawait"`
+	FlushIOPS    float64 `json:"flushiops"`
+	FlushAwait   float64 `json:"flushawait"`
+	AvgQueueSize float64 `json:"avgqueuesize"`
+	Util         float64 `json:"util"`
+	InFlight     uint64  `json:"inflight"`
 }
 
 type DiskRateResponse struct {
@@ -0,0 +1,17 @@
+package models
+
+type InterruptInfo struct {
+	IRQ         string    `json:"irq"`
+	Description string    `json:"description,omitempty"`
+	Total       uint64    `json:"total"`
+	PerCPU      []uint64  `json:"perCpu"`
+	Rate        float64   `json:"rate"`
+	PerCPURate  []float64 `json:"perCpuRate,omitempty"`
+}
+
+type InterruptsResponse struct {
+	CPUs       []int            `json:"cpus"`
+	Interrupts []*InterruptInfo `json:"interrupts"`
+	Softirqs   []*InterruptInfo `json:"softirqs"`
+	Cursor     string           `json:"cursor"`
+}
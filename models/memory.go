@@ -1,16 +1,71 @@
 package models
 
 type MemoryInfo struct {
-	Total        uint64  `json:"total"`
-	Used         uint64  `json:"used"`
-	UsedPercent  float64 `json:"usedPercent"`
-	Free         uint64  `json:"free"`
-	Available    uint64  `json:"available"`
-	Buffers      uint64  `json:"buffers"`
-	Cached       uint64  `json:"cached"`
-	SReclaimable uint64  `json:"sreclaimable"`
-	Shared       uint64  `json:"shared"`
-	ZfsArcSize   uint64  `json:"zfsArcSize"`
-	SwapTotal    uint64  `json:"swaptotal"`
-	SwapFree     uint64  `json:"swapfree"`
+	Total        uint64         `json:"total"`
+	Used         uint64         `json:"used"`
+	UsedPercent  float64        `json:"usedPercent"`
+	Free         uint64         `json:"free"`
+	Available    uint64         `json:"available"`
+	Buffers      uint64         `json:"buffers"`
+	Cached       uint64         `json:"cached"`
+	SReclaimable uint64         `json:"sreclaimable"`
+	Shared       uint64         `json:"shared"`
+	ZfsArcSize   uint64         `json:"zfsArcSize"`
+	SwapTotal    uint64         `json:"swaptotal"`
+	SwapFree     uint64         `json:"swapfree"`
+	Details      *MemoryDetails `json:"details,omitempty"`
+	Scope        string         `json:"scope,omitempty"`
+	Cgroup       string         `json:"cgroup,omitempty"`
+}
+
+type MemoryDetails struct {
+	Active         uint64            `json:"active"`
+	Inactive       uint64            `json:"inactive"`
+	ActiveAnon     uint64            `json:"activeAnon"`
+	InactiveAnon   uint64            `json:"inactiveAnon"`
+	ActiveFile     uint64            `json:"activeFile"`
+	InactiveFile   uint64            `json:"inactiveFile"`
+	Unevictable    uint64            `json:"unevictable"`
+	Mlocked        uint64            `json:"mlocked"`
+	SwapCached     uint64            `json:"swapCached"`
+	Dirty          uint64            `json:"dirty"`
+	Writeback      uint64            `json:"writeback"`
+	AnonPages      uint64            `json:"anonPages"`
+	Mapped         uint64            `json:"mapped"`
+	Shmem          uint64            `json:"shmem"`
+	Slab           uint64            `json:"slab"`
+	SReclaimable   uint64            `json:"sreclaimable"`
+	SUnreclaim     uint64            `json:"sunreclaim"`
+	KernelStack    uint64            `json:"kernelStack"`
+	PageTables     uint64            `json:"pageTables"`
+	Percpu         uint64            `json:"percpu"`
+	VmallocUsed    uint64            `json:"vmallocUsed"`
+	CommitLimit    uint64            `json:"commitLimit"`
+	CommittedAS    uint64            `json:"committedAs"`
+	AnonHugePages  uint64            `json:"anonHugePages"`
+	ShmemHugePages uint64            `json:"shmemHugePages"`
+	FileHugePages  uint64            `json:"fileHugePages"`
+	HugePages      HugePagesInfo     `json:"hugePages"`
+	KSM            *KSMInfo          `json:"ksm,omitempty"`
+	Meminfo        map[string]uint64 `json:"meminfo"`
+}
+
+type HugePagesInfo struct {
+	Total    uint64 `json:"total"`
+	Free     uint64 `json:"free"`
+	Reserved uint64 `json:"reserved"`
+	Surplus  uint64 `json:"surplus"`
+	PageSize uint64 `json:"pageSize"`
+	Hugetlb  uint64 `json:"hugetlb"`
+}
+
+type KSMInfo struct {
+	Running       bool   `json:"running"`
+	PagesShared   uint64 `json:"pagesShared"`
+	PagesSharing  uint64 `json:"pagesSharing"`
+	PagesUnshared uint64 `json:"pagesUnshared"`
+	PagesVolatile uint64 `json:"pagesVolatile"`
+	FullScans     uint64 `json:"fullScans"`
+	Saved         uint64 `json:"saved"`
+	GeneralProfit int64  `json:"generalProfit"`
 }
@@ -11,25 +11,51 @@ type SystemMetrics struct {
 }
 
 type SystemInfo struct {
-	LoadAvg   string `json:"loadavg"`
-	Processes int    `json:"processes"`
-	Threads   int    `json:"threads"`
-	BootTime  string `json:"boottime"`
+	LoadAvg   string          `json:"loadavg"`
+	Processes int             `json:"processes"`
+	Threads   int             `json:"threads"`
+	BootTime  string          `json:"boottime"`
+	Activity  *KernelActivity `json:"activity,omitempty"`
+	Cursor    string          `json:"cursor,omitempty"`
+}
+
+type KernelActivity struct {
+	ContextSwitches   uint64  `json:"contextSwitches"`
+	Forks             uint64  `json:"forks"`
+	Interrupts        uint64  `json:"interrupts"`
+	ProcsRunning      int     `json:"procsRunning"`
+	ProcsBlocked      int     `json:"procsBlocked"`
+	ContextSwitchRate float64 `json:"contextSwitchRate"`
+	ForkRate          float64 `json:"forkRate"`
+	InterruptRate     float64 `json:"interruptRate"`
 }
 
 type MetaInfo struct {
-	CPU        *CPUInfo             `json:"cpu,omitempty"`
-	Memory     *MemoryInfo          `json:"memory,omitempty"`
-	Network    []*NetworkInfo       `json:"network,omitempty"`
-	NetRate    *NetworkRateResponse `json:"netrate,omitempty"`
-	Disk       []*DiskInfo          `json:"disk,omitempty"`
-	DiskRate   *DiskRateResponse    `json:"diskrate,omitempty"`
-	DiskMounts []*DiskMountInfo     `json:"diskmounts,omitempty"`
-	Processes  []*ProcessInfo       `json:"processes,omitempty"`
-	System     *SystemInfo          `json:"system,omitempty"`
-	Hardware   *SystemHardware      `json:"hardware,omitempty"`
-	GPU        *GPUInfo             `json:"gpu,omitempty"`
-	Cursor     string               `json:"cursor,omitempty"`
+	CPU          *CPUInfo             `json:"cpu,omitempty"`
+	Memory       *MemoryInfo          `json:"memory,omitempty"`
+	Network      []*NetworkInfo       `json:"network,omitempty"`
+	NetRate      *NetworkRateResponse `json:"netrate,omitempty"`
+	Disk         []*DiskInfo          `json:"disk,omitempty"`
+	DiskRate     *DiskRateResponse    `json:"diskrate,omitempty"`
+	DiskMounts   []*DiskMountInfo     `json:"diskmounts,omitempty"`
+	MountsCursor string               `json:"diskmountsCursor,omitempty"`
+	BlockDevices []*BlockDevice       `json:"blockdevices,omitempty"`
+	Smart        []*SmartDevice       `json:"smart,omitempty"`
+	StoragePools []*StoragePool       `json:"storagepools,omitempty"`
+	Connections  *ConnectionsResponse `json:"connections,omitempty"`
+	NetStack     *NetStackResponse    `json:"netstack,omitempty"`
+	Processes    []*ProcessInfo       `json:"processes,omitempty"`
+	System       *SystemInfo          `json:"system,omitempty"`
+	Hardware     *SystemHardware      `json:"hardware,omitempty"`
+	Topology     *CPUTopology         `json:"topology,omitempty"`
+	GPU          *GPUInfo             `json:"gpu,omitempty"`
+	Pressure     *PressureResponse    `json:"pressure,omitempty"`
+	Interrupts   *InterruptsResponse  `json:"interrupts,omitempty"`
+	Sensors      []*SensorChip        `json:"sensors,omitempty"`
+	Zram         *ZramInfo            `json:"zram,omitempty"`
+	NUMA         *NUMAResponse        `json:"numa,omitempty"`
+	VMStat       *VMStatResponse      `json:"vmstat,omitempty"`
+	Cursor       string               `json:"cursor,omitempty"`
 }
 
 type ModulesInfo struct {
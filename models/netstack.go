@@ -0,0 +1,48 @@
+package models
+
+type NetStackCounters struct {
+	TcpOutSegs          uint64 `json:"tcpOutSegs"`
+	TcpRetransSegs      uint64 `json:"tcpRetransSegs"`
+	TcpEstabResets      uint64 `json:"tcpEstabResets"`
+	TcpOutRsts          uint64 `json:"tcpOutRsts"`
+	TcpAttemptFails     uint64 `json:"tcpAttemptFails"`
+	TcpInErrs           uint64 `json:"tcpInErrs"`
+	ListenOverflows     uint64 `json:"listenOverflows"`
+	ListenDrops         uint64 `json:"listenDrops"`
+	UdpInErrors         uint64 `json:"udpInErrors"`
+	UdpRcvbufErrors     uint64 `json:"udpRcvbufErrors"`
+	UdpSndbufErrors     uint64 `json:"udpSndbufErrors"`
+	UdpNoPorts          uint64 `json:"udpNoPorts"`
+	IcmpInDestUnreachs  uint64 `json:"icmpInDestUnreachs"`
+	IcmpOutDestUnreachs uint64 `json:"icmpOutDestUnreachs"`
+	SyncookiesSent      uint64 `json:"syncookiesSent"`
+	SyncookiesRecv      uint64 `json:"syncookiesRecv"`
+	SyncookiesFailed    uint64 `json:"syncookiesFailed"`
+}
+
+type NetStackRates struct {
+	TcpOutSegs          float64 `json:"tcpOutSegs"`
+	TcpRetransSegs      float64 `json:"tcpRetransSegs"`
+	TcpEstabResets      float64 `json:"tcpEstabResets"`
+	TcpOutRsts          float64 `json:"tcpOutRsts"`
+	TcpAttemptFails     float64 `json:"tcpAttemptFails"`
+	TcpInErrs           float64 `json:"tcpInErrs"`
+	ListenOverflows     float64 `json:"listenOverflows"`
+	ListenDrops         float64 `json:"listenDrops"`
+	UdpInErrors         float64 `json:"udpInErrors"`
+	UdpRcvbufErrors     float64 `json:"udpRcvbufErrors"`
+	UdpSndbufErrors     float64 `json:"udpSndbufErrors"`
+	UdpNoPorts          float64 `json:"udpNoPorts"`
+	IcmpInDestUnreachs  float64 `json:"icmpInDestUnreachs"`
+	IcmpOutDestUnreachs float64 `json:"icmpOutDestUnreachs"`
+	SyncookiesSent      float64 `json:"syncookiesSent"`
+	SyncookiesRecv      float64 `json:"syncookiesRecv"`
+	SyncookiesFailed    float64 `json:"syncookiesFailed"`
+}
+
+type NetStackResponse struct {
+	Counters          NetStackCounters `json:"counters"`
+	Rates             NetStackRates    `json:"rates"`
+	RetransmitPercent float64          `json:"retransmitPercent"`
+	Cursor            string           `json:"cursor"`
+}
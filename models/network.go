@@ -1,20 +1,50 @@
 package models
 
 type NetworkInfo struct {
-	Name string `json:"name"`
-	Rx   uint64 `json:"rx"`
-	Tx   uint64 `json:"tx"`
+	Name      string        `json:"name"`
+	Rx        uint64        `json:"rx"`
+	Tx        uint64        `json:"tx"`
+	IPv4      []string      `json:"ipv4,omitempty"`
+	IPv6      []string      `json:"ipv6,omitempty"`
+	MAC       string        `json:"mac,omitempty"`
+	MTU       int           `json:"mtu,omitempty"`
+	OperState string        `json:"operstate,omitempty"`
+	Carrier   bool          `json:"carrier"`
+	Speed     int           `json:"speed,omitempty"`
+	Duplex    string        `json:"duplex,omitempty"`
+	Driver    string        `json:"driver,omitempty"`
+	Type      string        `json:"type,omitempty"`
+	Wireless  *WirelessInfo `json:"wireless,omitempty"`
+}
+
+type WirelessInfo struct {
+	Quality float64 `json:"quality"`
+	Signal  float64 `json:"signal"`
+	Noise   float64 `json:"noise,omitempty"`
 }
 
 type NetworkRateInfo struct {
-	Interface string  `json:"interface"`
-	RxRate    float64 `json:"rxrate"`
-	TxRate    float64 `json:"txrate"`
-	RxTotal   uint64  `json:"rxtotal"`
-	TxTotal   uint64  `json:"txtotal"`
+	Interface    string  `json:"interface"`
+	RxRate       float64 `json:"rxrate"`
+	TxRate       float64 `json:"txrate"`
+	RxTotal      uint64  `json:"rxtotal"`
+	TxTotal      uint64  `json:"txtotal"`
+	RxPacketRate float64 `json:"rxpacketrate"`
+	TxPacketRate float64 `json:"txpacketrate"`
+	RxErrorRate  float64 `json:"rxerrorrate"`
+	TxErrorRate  float64 `json:"txerrorrate"`
+	RxDropRate   float64 `json:"rxdroprate"`
+	TxDropRate   float64 `json:"txdroprate"`
 }
 
 type NetworkRateResponse struct {
 	Interfaces []*NetworkRateInfo `json:"interfaces"`
 	Cursor     string             `json:"cursor"`
 }
+
+type NetworkFilter struct {
+	Preset  string   `json:"preset,omitempty"`
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+	Types   []string `json:"types,omitempty"`
+}
@@ -0,0 +1,27 @@
+package models
+
+type NUMANodeInfo struct {
+	Node          int     `json:"node"`
+	CPUs          []int   `json:"cpus"`
+	Total         uint64  `json:"total"`
+	Free          uint64  `json:"free"`
+	Used          uint64  `json:"used"`
+	UsedPercent   float64 `json:"usedPercent"`
+	FilePages     uint64  `json:"filePages"`
+	AnonPages     uint64  `json:"anonPages"`
+	NumaHit       uint64  `json:"numaHit"`
+	NumaMiss      uint64  `json:"numaMiss"`
+	NumaForeign   uint64  `json:"numaForeign"`
+	InterleaveHit uint64  `json:"interleaveHit"`
+	LocalNode     uint64  `json:"localNode"`
+	OtherNode     uint64  `json:"otherNode"`
+	HitRate       float64 `json:"hitRate"`
+	MissRate      float64 `json:"missRate"`
+	ForeignRate   float64 `json:"foreignRate"`
+	MissPercent   float64 `json:"missPercent"`
+}
+
+type NUMAResponse struct {
+	Nodes  []*NUMANodeInfo `json:"nodes"`
+	Cursor string          `json:"cursor"`
+}
@@ -0,0 +1,20 @@
+package models
+
+type PressureStat struct {
+	Avg10        float64 `json:"avg10"`
+	Avg60        float64 `json:"avg60"`
+	Avg300       float64 `json:"avg300"`
+	Total        uint64  `json:"total"`
+	StallPercent float64 `json:"stallPercent"`
+}
+
+type PressureInfo struct {
+	Resource string        `json:"resource"`
+	Some     *PressureStat `json:"some,omitempty"`
+	Full     *PressureStat `json:"full,omitempty"`
+}
+
+type PressureResponse struct {
+	Resources []*PressureInfo `json:"resources"`
+	Cursor    string          `json:"cursor"`
+}
@@ -1,32 +1,56 @@
 package models
 
 type ProcessInfo struct {
-	PID               int32   `json:"pid"`
-	PPID              int32   `json:"ppid"`
-	CPU               float64 `json:"cpu"`
-	PTicks            float64 `json:"pticks"`
-	MemoryPercent     float32 `json:"memoryPercent"`
-	MemoryKB          uint64  `json:"memoryKB"`
-	MemoryCalculation string  `json:"memoryCalculation"`
-	RSSKB             uint64  `json:"rssKB"`
-	RSSPercent        float32 `json:"rssPercent"`
-	PSSKB             uint64  `json:"pssKB"`
-	PSSPercent        float32 `json:"pssPercent"`
-	Username          string  `json:"username"`
-	Command           string  `json:"command"`
-	FullCommand       string  `json:"fullCommand"`
-	ExecutablePath    string  `json:"executablePath,omitempty"`
-	ChildCount        int     `json:"childCount,omitempty"`
+	PID               int32               `json:"pid"`
+	PPID              int32               `json:"ppid"`
+	CPU               float64             `json:"cpu"`
+	PTicks            float64             `json:"pticks"`
+	MemoryPercent     float32             `json:"memoryPercent"`
+	MemoryKB          uint64              `json:"memoryKB"`
+	MemoryCalculation string              `json:"memoryCalculation"`
+	RSSKB             uint64              `json:"rssKB"`
+	RSSPercent        float32             `json:"rssPercent"`
+	PSSKB             uint64              `json:"pssKB"`
+	PSSPercent        float32             `json:"pssPercent"`
+	Username          string              `json:"username"`
+	Command           string              `json:"command"`
+	FullCommand       string              `json:"fullCommand"`
+	ExecutablePath    string              `json:"executablePath,omitempty"`
+	ChildCount        int                 `json:"childCount,omitempty"`
+	IO                *ProcessIOCounters  `json:"io,omitempty"`
+	IOReadRate        float64             `json:"ioReadRate"`
+	IOWriteRate       float64             `json:"ioWriteRate"`
+	IOReadCallRate    float64             `json:"ioReadCallRate"`
+	IOWriteCallRate   float64             `json:"ioWriteCallRate"`
+	Net               *ProcessNetCounters `json:"net,omitempty"`
+	NetRxRate         float64             `json:"netRxRate"`
+	NetTxRate         float64             `json:"netTxRate"`
+}
+
+type ProcessIOCounters struct {
+	ReadBytes  uint64 `json:"readBytes"`
+	WriteBytes uint64 `json:"writeBytes"`
+	SyscR      uint64 `json:"syscr"`
+	SyscW      uint64 `json:"syscw"`
+}
+
+type ProcessNetCounters struct {
+	RxBytes uint64 `json:"rxBytes"`
+	TxBytes uint64 `json:"txBytes"`
+	Source  string `json:"source"`
+	Netns   string `json:"netns,omitempty"`
 }
 
 type ProcessCursorData struct {
-	PID       int32   `json:"pid"`
-	Ticks     float64 `json:"ticks"`
-	Timestamp int64   `json:"timestamp"`
-	Name      string  `json:"name,omitempty"`
-	Cmdline   string  `json:"cmdline,omitempty"`
-	Username  string  `json:"username,omitempty"`
-	PPID      int32   `json:"ppid,omitempty"`
+	PID       int32               `json:"pid"`
+	Ticks     float64             `json:"ticks"`
+	Timestamp int64               `json:"timestamp"`
+	Name      string              `json:"name,omitempty"`
+	Cmdline   string              `json:"cmdline,omitempty"`
+	Username  string              `json:"username,omitempty"`
+	PPID      int32               `json:"ppid,omitempty"`
+	IO        *ProcessIOCounters  `json:"io,omitempty"`
+	Net       *ProcessNetCounters `json:"net,omitempty"`
 }
 
 type ProcessListResponse struct {
@@ -0,0 +1,19 @@
+package models
+
+type SensorChip struct {
+	Name    string           `json:"name"`
+	Hwmon   string           `json:"hwmon"`
+	Sensors []*SensorReading `json:"sensors"`
+}
+
+type SensorReading struct {
+	Type    string   `json:"type"`
+	Channel string   `json:"channel"`
+	Label   string   `json:"label,omitempty"`
+	Unit    string   `json:"unit"`
+	Input   float64  `json:"input"`
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Crit    *float64 `json:"crit,omitempty"`
+	Alarms  []string `json:"alarms,omitempty"`
+}
@@ -0,0 +1,29 @@
+package models
+
+type SmartDevice struct {
+	Name               string  `json:"name"`
+	Path               string  `json:"path"`
+	Type               string  `json:"type,omitempty"`
+	Protocol           string  `json:"protocol,omitempty"`
+	Source             string  `json:"source"`
+	Model              string  `json:"model,omitempty"`
+	Serial             string  `json:"serial,omitempty"`
+	Firmware           string  `json:"firmware,omitempty"`
+	Capacity           uint64  `json:"capacity,omitempty"`
+	Health             string  `json:"health"`
+	Temperature        float64 `json:"temperature,omitempty"`
+	PowerOnHours       uint64  `json:"powerOnHours,omitempty"`
+	PowerCycles        uint64  `json:"powerCycles,omitempty"`
+	ReallocatedSectors uint64  `json:"reallocatedSectors"`
+	PendingSectors     uint64  `json:"pendingSectors"`
+	Uncorrectable      uint64  `json:"uncorrectable"`
+	PercentageUsed     *int    `json:"percentageUsed,omitempty"`
+	AvailableSpare     *int    `json:"availableSpare,omitempty"`
+	CriticalWarning    int     `json:"criticalWarning"`
+	MediaErrors        uint64  `json:"mediaErrors"`
+	UnsafeShutdowns    uint64  `json:"unsafeShutdowns"`
+	DataRead           uint64  `json:"dataRead,omitempty"`
+	DataWritten        uint64  `json:"dataWritten,omitempty"`
+	Skipped            string  `json:"skipped,omitempty"`
+	Error              string  `json:"error,omitempty"`
+}
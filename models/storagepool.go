@@ -0,0 +1,28 @@
+package models
+
+type StoragePoolMember struct {
+	Name           string `json:"name"`
+	State          string `json:"state"`
+	Status         string `json:"status"`
+	ReadErrors     uint64 `json:"readErrors"`
+	WriteErrors    uint64 `json:"writeErrors"`
+	ChecksumErrors uint64 `json:"checksumErrors"`
+}
+
+type StoragePool struct {
+	Name       string              `json:"name"`
+	Kind       string              `json:"kind"`
+	Level      string              `json:"level,omitempty"`
+	State      string              `json:"state"`
+	Status     string              `json:"status"`
+	Size       uint64              `json:"size,omitempty"`
+	Devices    int                 `json:"devices,omitempty"`
+	Working    int                 `json:"working,omitempty"`
+	Members    []StoragePoolMember `json:"members"`
+	Errors     uint64              `json:"errors"`
+	Operation  string              `json:"operation,omitempty"`
+	Progress   float64             `json:"progress,omitempty"`
+	ETASeconds float64             `json:"etaSeconds,omitempty"`
+	Speed      uint64              `json:"speed,omitempty"`
+	Message    string              `json:"message,omitempty"`
+}
@@ -0,0 +1,36 @@
+package models
+
+type CPUTopology struct {
+	Packages  int           `json:"packages"`
+	Dies      int           `json:"dies"`
+	Cores     int           `json:"cores"`
+	Threads   int           `json:"threads"`
+	Hybrid    bool          `json:"hybrid"`
+	CPUs      []CPUThread   `json:"cpus"`
+	Caches    []CPUCache    `json:"caches"`
+	NUMANodes []CPUNUMANode `json:"numaNodes"`
+}
+
+type CPUThread struct {
+	CPU      int    `json:"cpu"`
+	Package  int    `json:"package"`
+	Die      int    `json:"die"`
+	Core     int    `json:"core"`
+	Siblings []int  `json:"siblings"`
+	NUMANode int    `json:"numaNode"`
+	CoreType string `json:"coreType,omitempty"`
+	Capacity int    `json:"capacity,omitempty"`
+}
+
+type CPUCache struct {
+	Level      int    `json:"level"`
+	Type       string `json:"type"`
+	Size       uint64 `json:"size"`
+	LineSize   int    `json:"lineSize,omitempty"`
+	SharedCPUs []int  `json:"sharedCpus"`
+}
+
+type CPUNUMANode struct {
+	Node int   `json:"node"`
+	CPUs []int `json:"cpus"`
+}
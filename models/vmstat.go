@@ -0,0 +1,47 @@
+package models
+
+type VMStatCounters struct {
+	PgFault          uint64 `json:"pgfault"`
+	PgMajFault       uint64 `json:"pgmajfault"`
+	PgpgIn           uint64 `json:"pgpgin"`
+	PgpgOut          uint64 `json:"pgpgout"`
+	PswpIn           uint64 `json:"pswpin"`
+	PswpOut          uint64 `json:"pswpout"`
+	PgScanDirect     uint64 `json:"pgscanDirect"`
+	PgScanKswapd     uint64 `json:"pgscanKswapd"`
+	PgStealDirect    uint64 `json:"pgstealDirect"`
+	PgStealKswapd    uint64 `json:"pgstealKswapd"`
+	AllocStall       uint64 `json:"allocstall"`
+	ThpFaultAlloc    uint64 `json:"thpFaultAlloc"`
+	ThpFaultFallback uint64 `json:"thpFaultFallback"`
+	ThpCollapseAlloc uint64 `json:"thpCollapseAlloc"`
+	ThpCollapseFail  uint64 `json:"thpCollapseAllocFailed"`
+	OomKill          uint64 `json:"oomKill"`
+}
+
+type VMStatRates struct {
+	PgFault          float64 `json:"pgfault"`
+	PgMajFault       float64 `json:"pgmajfault"`
+	PgpgIn           float64 `json:"pgpgin"`
+	PgpgOut          float64 `json:"pgpgout"`
+	PswpIn           float64 `json:"pswpin"`
+	PswpOut          float64 `json:"pswpout"`
+	PgScanDirect     float64 `json:"pgscanDirect"`
+	PgScanKswapd     float64 `json:"pgscanKswapd"`
+	PgStealDirect    float64 `json:"pgstealDirect"`
+	PgStealKswapd    float64 `json:"pgstealKswapd"`
+	AllocStall       float64 `json:"allocstall"`
+	ThpFaultAlloc    float64 `json:"thpFaultAlloc"`
+	ThpFaultFallback float64 `json:"thpFaultFallback"`
+	ThpCollapseAlloc float64 `json:"thpCollapseAlloc"`
+	ThpCollapseFail  float64 `json:"thpCollapseAllocFailed"`
+	OomKill          float64 `json:"oomKill"`
+}
+
+type VMStatResponse struct {
+	Counters          VMStatCounters `json:"counters"`
+	Rates             VMStatRates    `json:"rates"`
+	ReclaimEfficiency float64        `json:"reclaimEfficiency"`
+	OomKills          uint64         `json:"oomKills"`
+	Cursor            string         `json:"cursor"`
+}
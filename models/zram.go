@@ -0,0 +1,33 @@
+package models
+
+type ZramInfo struct {
+	Devices []*ZramDevice `json:"devices"`
+	Zswap   *ZswapInfo    `json:"zswap,omitempty"`
+}
+
+type ZramDevice struct {
+	Device           string  `json:"device"`
+	Algorithm        string  `json:"algorithm"`
+	DiskSize         uint64  `json:"diskSize"`
+	OrigData         uint64  `json:"origData"`
+	ComprData        uint64  `json:"comprData"`
+	MemUsed          uint64  `json:"memUsed"`
+	MemLimit         uint64  `json:"memLimit"`
+	MemUsedMax       uint64  `json:"memUsedMax"`
+	SamePages        uint64  `json:"samePages"`
+	HugePages        uint64  `json:"hugePages"`
+	CompressionRatio float64 `json:"compressionRatio"`
+}
+
+type ZswapInfo struct {
+	Enabled          bool    `json:"enabled"`
+	Compressor       string  `json:"compressor,omitempty"`
+	Zpool            string  `json:"zpool,omitempty"`
+	MaxPoolPercent   int     `json:"maxPoolPercent"`
+	OrigData         uint64  `json:"origData"`
+	PoolSize         uint64  `json:"poolSize"`
+	StoredPages      uint64  `json:"storedPages,omitempty"`
+	WrittenBackPages uint64  `json:"writtenBackPages,omitempty"`
+	PoolLimitHit     uint64  `json:"poolLimitHit,omitempty"`
+	CompressionRatio float64 `json:"compressionRatio"`
+}